@@ -0,0 +1 @@
+"""Analysis of extracted Hansard data: topics, participation, and performance metrics."""
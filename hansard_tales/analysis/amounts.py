@@ -0,0 +1,74 @@
+#!/usr/bin/env python3
+"""
+Monetary amounts mentioned in debate.
+
+Members quote Kenyan shilling figures in many forms: "Kshs 1.2 billion",
+"KES 500 million", "Sh 20,000", "Kshs.3bn". This module finds them and
+normalizes each to a plain number of shillings for fiscal reporting.
+
+Usage:
+    from hansard_tales.analysis.amounts import extract_amounts
+
+    for money in extract_amounts(statement.text):
+        print(money.raw, money.value)
+"""
+import re
+from dataclasses import dataclass
+from typing import List
+
+
+# Scale words following a figure, by lowercase spelling
+MULTIPLIERS = {
+    'thousand': 1_000,
+    'million': 1_000_000,
+    'm': 1_000_000,
+    'billion': 1_000_000_000,
+    'bn': 1_000_000_000,
+    'trillion': 1_000_000_000_000,
+}
+
+# "Kshs 1.2 billion", "KES 500 million", "Sh 20,000", "Kshs.3bn"
+AMOUNT_PATTERN = re.compile(
+    r'\b(?:KES|Kshs?|KSh|Shs?)\.?\s*'
+    r'(?P<number>\d{1,3}(?:,\d{3})+(?:\.\d+)?|\d+(?:\.\d+)?)'
+    r'(?:\s*(?P<multiplier>thousand|million|billion|trillion|bn|m)\b)?',
+    re.IGNORECASE
+)
+
+
+@dataclass
+class Money:
+    """Represents a shilling amount quoted in text."""
+    value: float
+    raw: str
+
+
+def extract_amounts(text: str) -> List[Money]:
+    """
+    Find Kenyan shilling amounts in text.
+
+    Only figures with a currency marker (KES, Kshs, Ksh, Sh or Shs) are
+    extracted, so bare numbers such as clause or question numbers are not
+    mistaken for money.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        List of Money objects in the order they appear, with the value in
+        shillings and the matched text
+    """
+    if not text:
+        return []
+
+    amounts = []
+    for match in AMOUNT_PATTERN.finditer(text):
+        value = float(match.group('number').replace(',', ''))
+
+        multiplier = match.group('multiplier')
+        if multiplier:
+            value *= MULTIPLIERS[multiplier.lower()]
+
+        amounts.append(Money(value=value, raw=match.group(0)))
+
+    return amounts
@@ -0,0 +1,152 @@
+#!/usr/bin/env python3
+"""
+Attendance metrics for MPs.
+
+Attendance is a percentage of sittings attended (0-100), keyed by MP id
+(mp['id']).
+
+Usage:
+    from hansard_tales.analysis.attendance import attendance_by_county, rank_counties
+
+    ranking = rank_counties(attendance_by_county(mps, attendance))
+    trend = cumulative_attendance(present_by_session, ordered_sessions)
+    silent = silent_mps(present_ids, statements_by_mp, mps)
+    longest_present, longest_absent = attendance_streaks(flags_in_sitting_order)
+"""
+from collections import defaultdict
+from typing import Dict, List, Tuple
+
+from hansard_tales.processors.constituency_normalizer import ConstituencyNormalizer
+
+
+def cumulative_attendance(
+    present_by_session: Dict[str, Dict[object, bool]],
+    ordered_sessions: List[str]
+) -> Dict[object, List[float]]:
+    """
+    Running attendance percentage for each MP over a term.
+
+    Entry i of an MP's series is their attendance over the first i + 1
+    sessions, so the series shows whether attendance is improving. An MP
+    missing from a session's flags is counted as absent, keeping every
+    series aligned with ordered_sessions.
+
+    Args:
+        present_by_session: Mapping of session id to {MP id: present}
+        ordered_sessions: Session ids in sitting order
+
+    Returns:
+        Mapping of MP id to attendance percentages, one per session
+    """
+    mp_ids = dict.fromkeys(
+        mp_id for session in ordered_sessions for mp_id in present_by_session.get(session, {})
+    )
+
+    series: Dict[object, List[float]] = {mp_id: [] for mp_id in mp_ids}
+    attended: Dict[object, int] = defaultdict(int)
+
+    for count, session in enumerate(ordered_sessions, start=1):
+        present = present_by_session.get(session, {})
+        for mp_id in mp_ids:
+            if present.get(mp_id):
+                attended[mp_id] += 1
+            series[mp_id].append(attended[mp_id] / count * 100)
+
+    return series
+
+
+def attendance_streaks(present: List[bool]) -> Tuple[int, int]:
+    """
+    Longest runs of consecutive sittings attended and missed by one MP.
+
+    A long absent run is more telling than a flat attendance rate, which
+    can hide weeks away in an otherwise good record.
+
+    Args:
+        present: The MP's presence flags in sitting order
+
+    Returns:
+        Tuple of (longest run present, longest run absent); (0, 0) for no
+        sittings
+    """
+    longest = {True: 0, False: 0}
+    run = 0
+    previous = None
+
+    for flag in present:
+        flag = bool(flag)
+        run = run + 1 if flag == previous else 1
+        previous = flag
+        longest[flag] = max(longest[flag], run)
+
+    return longest[True], longest[False]
+
+
+def attendance_by_county(mps: List[Dict], attendance: Dict[object, float]) -> Dict[str, float]:
+    """
+    Average MP attendance per county.
+
+    The county comes from the MP's constituency where it names one (see
+    ConstituencyNormalizer.county_of), otherwise from the stored county.
+    County names are canonicalized so that "NAIROBI" and "Nairobi County"
+    are grouped together. MPs without a recognised county (such as
+    nominated members) or without attendance data are skipped, and
+    counties with no data are omitted rather than reported as zero.
+
+    Args:
+        mps: MP dictionaries with 'id', 'constituency' and 'county' keys
+        attendance: Mapping of MP id to attendance percentage
+
+    Returns:
+        Mapping of county name to mean attendance percentage
+    """
+    normalizer = ConstituencyNormalizer()
+    rates: Dict[str, List[float]] = defaultdict(list)
+
+    for mp in mps:
+        county = (
+            normalizer.county_of(mp.get('constituency'))
+            or normalizer.canonical_county(mp.get('county'))
+        )
+        rate = attendance.get(mp.get('id'))
+
+        if county and rate is not None:
+            rates[county].append(rate)
+
+    return {county: sum(values) / len(values) for county, values in rates.items()}
+
+
+def rank_counties(by_county: Dict[str, float]) -> List[Tuple[str, float]]:
+    """
+    Rank counties from highest to lowest attendance.
+
+    Args:
+        by_county: Mapping of county to attendance, from attendance_by_county
+
+    Returns:
+        List of (county, attendance) tuples, highest first, ties alphabetical
+    """
+    return sorted(by_county.items(), key=lambda item: (-item[1], item[0]))
+
+
+def silent_mps(
+    present_ids: List[object],
+    statements_by_mp: Dict[object, List],
+    mps: List[Dict]
+) -> List[object]:
+    """
+    List MPs who attended a sitting but never spoke.
+
+    Args:
+        present_ids: Ids of MPs marked present
+        statements_by_mp: Mapping of MP id to their statements in the sitting
+        mps: MP dictionaries with 'id' and 'name', used for ordering
+
+    Returns:
+        Ids of present MPs with no statements, sorted by name
+    """
+    names = {mp.get('id'): mp.get('name') or '' for mp in mps}
+
+    silent = {mp_id for mp_id in present_ids if not statements_by_mp.get(mp_id)}
+
+    return sorted(silent, key=lambda mp_id: (names.get(mp_id, ''), str(mp_id)))
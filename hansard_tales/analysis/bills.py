@@ -0,0 +1,222 @@
+#!/usr/bin/env python3
+"""
+Bill progress tracking across sittings.
+
+Bill records are dictionaries with 'id' and 'status' keys, and optionally
+'title', 'assented_at' (YYYY-MM-DD) once the President has assented,
+'money_bill' (True for Money Bills, which the Constitution handles
+separately under Article 114), and 'sponsor_mp_id' and 'co_sponsor_mp_ids'
+(a list) naming the MPs who brought the bill. A bill moves
+through the readings in BILL_STAGES order and may be withdrawn or defeated
+at any point before it is passed.
+
+Usage:
+    from hansard_tales.analysis.bills import track_bill_progress
+
+    timeline = track_bill_progress({'2024-03-05': bills, '2024-03-12': later_bills})
+    record_money_bill(bill, bill_text)
+    key = normalize_bill_title("The Finance Bill, 2025")
+    edges = co_sponsorship_edges(bills)
+"""
+import logging
+import re
+from collections import Counter
+from itertools import combinations
+from typing import Dict, List, Optional, Tuple
+
+from hansard_tales.dates import extract_assent_date
+from hansard_tales.processors.bill_extractor import is_money_bill
+
+
+# Configure logging
+logging.basicConfig(
+    level=logging.INFO,
+    format='%(asctime)s - %(levelname)s - %(message)s'
+)
+logger = logging.getLogger(__name__)
+
+
+FIRST_READING = 'first_reading'
+SECOND_READING = 'second_reading'
+COMMITTEE_OF_THE_WHOLE_HOUSE = 'committee_of_the_whole_house'
+THIRD_READING = 'third_reading'
+PASSED = 'passed'
+ASSENTED = 'assented'
+WITHDRAWN = 'withdrawn'
+DEFEATED = 'defeated'
+
+# Stages in the order a bill passes through them
+BILL_STAGES = [
+    FIRST_READING,
+    SECOND_READING,
+    COMMITTEE_OF_THE_WHOLE_HOUSE,
+    THIRD_READING,
+    PASSED,
+    ASSENTED,
+]
+
+# Outcomes that end a bill before it is passed
+TERMINAL_STATUSES = {WITHDRAWN, DEFEATED}
+
+# Anything other than letters, digits and spaces in a bill title
+TITLE_PUNCTUATION_PATTERN = re.compile(r'[^\w\s]|_')
+
+# Leading article in "The Finance Bill, 2025"
+LEADING_THE_PATTERN = re.compile(r'^the\s+')
+
+
+def normalize_bill_title(title: str) -> str:
+    """
+    Reduce a bill title to a key for grouping mentions of the same bill.
+
+    Case, a leading "The", punctuation (including the comma before the
+    year) and spacing are ignored, so "The Finance Bill, 2025" and
+    "Finance Bill 2025" give the same key.
+
+    Args:
+        title: Bill title as printed
+
+    Returns:
+        Lowercase key such as "finance bill 2025"
+    """
+    if not title:
+        return ''
+
+    key = ' '.join(TITLE_PUNCTUATION_PATTERN.sub(' ', title.lower()).split())
+    return LEADING_THE_PATTERN.sub('', key)
+
+
+def valid_bill_transition(old: Optional[str], new: str) -> bool:
+    """
+    Check whether a bill can move from one status to another.
+
+    A bill may stay where it is, advance to the next stage, or be withdrawn
+    or defeated before it is passed. Skipping a stage, going backwards, or
+    leaving a terminal status is not possible.
+
+    Args:
+        old: Previous status (None for a bill not seen before)
+        new: New status
+
+    Returns:
+        True if the transition is possible
+    """
+    if old is None:
+        return new in BILL_STAGES or new in TERMINAL_STATUSES
+    if old == new:
+        return True
+    if old in TERMINAL_STATUSES or old not in BILL_STAGES:
+        return False
+    if new in TERMINAL_STATUSES:
+        return BILL_STAGES.index(old) < BILL_STAGES.index(PASSED)
+    if new not in BILL_STAGES:
+        return False
+    return BILL_STAGES.index(new) == BILL_STAGES.index(old) + 1
+
+
+def track_bill_progress(bills_by_session: Dict[str, List[Dict]]) -> Dict[str, List[str]]:
+    """
+    Build each bill's sequence of statuses across sittings.
+
+    Sittings are processed in date order and a status is recorded only
+    when it differs from the bill's previous one. Impossible transitions
+    are kept in the timeline but logged as warnings. Records without an id
+    are keyed on their normalized title (see normalize_bill_title).
+
+    Args:
+        bills_by_session: Mapping of sitting date (YYYY-MM-DD) to the bill
+            records seen in that sitting
+
+    Returns:
+        Mapping of bill id (or title key) to its ordered list of statuses
+    """
+    progress: Dict[str, List[str]] = {}
+
+    for sitting_date in sorted(bills_by_session):
+        for bill in bills_by_session[sitting_date]:
+            bill_id = bill.get('id')
+            if bill_id is None:
+                bill_id = normalize_bill_title(bill.get('title', '')) or None
+            status = bill.get('status')
+            if bill_id is None or not status:
+                continue
+
+            timeline = progress.setdefault(bill_id, [])
+            previous = timeline[-1] if timeline else None
+            if status == previous:
+                continue
+
+            if not valid_bill_transition(previous, status):
+                logger.warning(
+                    f"Bill {bill_id}: unexpected transition {previous} -> {status} on {sitting_date}"
+                )
+
+            timeline.append(status)
+
+    return progress
+
+
+def record_assent_date(bill: Dict, text: str) -> Optional[str]:
+    """
+    Record when a bill was assented to, from the text announcing it.
+
+    The bill's 'assented_at' is set only when the text gives an assent
+    date; otherwise the record is left unchanged.
+
+    Args:
+        bill: Bill dictionary to update
+        text: Text announcing assent, e.g. a Communication from the Chair
+
+    Returns:
+        Assent date in YYYY-MM-DD format, or None if none was given
+
+    Raises:
+        InvalidDateError: If the assent date is not a real date
+    """
+    assented_at = extract_assent_date(text)
+    if assented_at:
+        bill['assented_at'] = assented_at
+    return assented_at
+
+
+def record_money_bill(bill: Dict, text: str) -> bool:
+    """
+    Flag a bill record as a Money Bill or not, from its text.
+
+    Args:
+        bill: Bill dictionary to update
+        text: Bill text or the Speaker's communication on it
+
+    Returns:
+        The 'money_bill' value stored on the bill
+    """
+    bill['money_bill'] = is_money_bill(text)
+    return bill['money_bill']
+
+
+def co_sponsorship_edges(bills: List[Dict]) -> Dict[Tuple[object, object], int]:
+    """
+    Count how often each pair of MPs sponsored a bill together.
+
+    A bill's sponsors are its 'sponsor_mp_id' and 'co_sponsor_mp_ids'. Each
+    pair of distinct sponsors is counted once per bill, giving the weighted
+    edges of a collaboration graph.
+
+    Args:
+        bills: Bill dictionaries
+
+    Returns:
+        Mapping of (MP id, MP id) pair, in sorted order, to the number of
+        bills the two sponsored together
+    """
+    edges: Counter = Counter()
+
+    for bill in bills:
+        sponsors = set(bill.get('co_sponsor_mp_ids') or [])
+        if bill.get('sponsor_mp_id') is not None:
+            sponsors.add(bill['sponsor_mp_id'])
+        sponsors.discard(None)
+
+        edges.update(combinations(sorted(sponsors), 2))
+
+    return dict(edges)
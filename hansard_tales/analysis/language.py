@@ -0,0 +1,92 @@
+#!/usr/bin/env python3
+"""
+Language detection for MP statements.
+
+Members may address the House in English or Kiswahili, and often switch
+between them. Each statement is assigned the language most of its common
+function words belong to; a sitting's language mix weights statements by
+their spoken word count.
+
+Usage:
+    from hansard_tales.analysis.language import detect_language, language_mix
+
+    language = detect_language(statement.text)
+    mix = language_mix(statements)  # {"en": 0.9, "sw": 0.1}
+"""
+import re
+from collections import Counter
+from typing import Dict, List
+
+from hansard_tales.analysis.speech_metrics import count_words, strip_annotations
+from hansard_tales.processors.mp_identifier import Statement
+
+
+LANGUAGE_ENGLISH = 'en'
+LANGUAGE_SWAHILI = 'sw'
+LANGUAGE_UNKNOWN = 'unknown'
+
+# Frequent function words that identify each language
+LANGUAGE_WORDS = {
+    LANGUAGE_ENGLISH: {
+        'the', 'and', 'of', 'to', 'is', 'in', 'that', 'this', 'for', 'we',
+        'are', 'it', 'with', 'be', 'have', 'not', 'our', 'was', 'on', 'will',
+    },
+    LANGUAGE_SWAHILI: {
+        'na', 'ya', 'wa', 'kwa', 'ni', 'za', 'katika', 'hii', 'hiyo', 'sisi',
+        'sana', 'kwamba', 'lakini', 'kama', 'hapa', 'watu', 'yetu', 'hawa',
+        'ambao', 'pia', 'kuwa', 'asante', 'mheshimiwa', 'spika',
+    },
+}
+
+WORD_PATTERN = re.compile(r"[a-z]+")
+
+
+def detect_language(text: str) -> str:
+    """
+    Detect the language a statement is mostly spoken in.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        LANGUAGE_ENGLISH or LANGUAGE_SWAHILI, or LANGUAGE_UNKNOWN if the text
+        has no function words of either language or has equally many
+    """
+    words = WORD_PATTERN.findall(strip_annotations(text).lower())
+    hits = Counter(
+        language
+        for word in words
+        for language, vocabulary in LANGUAGE_WORDS.items()
+        if word in vocabulary
+    )
+
+    ranked = hits.most_common(2)
+    if not ranked or (len(ranked) == 2 and ranked[0][1] == ranked[1][1]):
+        return LANGUAGE_UNKNOWN
+
+    return ranked[0][0]
+
+
+def language_mix(statements: List[Statement]) -> Dict[str, float]:
+    """
+    Measure the share of a sitting's spoken words in each language.
+
+    Every word of a statement is attributed to the statement's detected
+    language.
+
+    Args:
+        statements: Statements from the sitting
+
+    Returns:
+        Mapping of language to fraction of words (summing to 1.0); only
+        languages that were spoken appear, and an empty sitting gives {}
+    """
+    words: Counter = Counter()
+    for statement in statements:
+        words[detect_language(statement.text)] += count_words(statement.text)
+
+    total = sum(words.values())
+    if not total:
+        return {}
+
+    return {language: count / total for language, count in words.items() if count}
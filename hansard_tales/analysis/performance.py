@@ -0,0 +1,252 @@
+#!/usr/bin/env python3
+"""
+Composite MP performance scores.
+
+An MP's performance score combines three component scores, each on a 0-100
+scale:
+
+- attendance: percentage of sittings attended
+- bills: legislative activity, from the number of bills sponsored
+- quality: share of the MP's statements that are substantive contributions
+  rather than interjections
+
+The weights given to each component are this project's editorial choice,
+not an official Parliamentary measure. Attendance is weighted most because
+it is recorded for every sitting. Bills and quality depend on parsing the
+Hansard and on an MP's role (presiding officers rarely sponsor bills or
+debate), so each is weighted less. Use performance_breakdown to show
+readers how a score was reached.
+
+Usage:
+    from hansard_tales.analysis.performance import calculate_performance_score
+
+    score = calculate_performance_score(attendance=85.0, bills=40.0, quality=62.5)
+    breakdown = performance_breakdown(attendance=85.0, bills=40.0, quality=62.5)
+    typical = median_performance(scores_by_mp)
+"""
+from collections import defaultdict
+from dataclasses import dataclass
+from typing import Dict, List, Optional, Tuple
+
+from hansard_tales.analysis.speech_metrics import count_words
+from hansard_tales.mps import current_party
+from hansard_tales.numeric import float_equal
+from hansard_tales.processors.constituency_normalizer import ConstituencyNormalizer
+from hansard_tales.processors.mp_identifier import Statement
+
+
+# Component weights (sum to 1.0); see the module docstring for the rationale
+ATTENDANCE_WEIGHT = 0.4
+BILLS_WEIGHT = 0.3
+QUALITY_WEIGHT = 0.3
+
+# Sponsoring this many bills earns the full bills score
+BILLS_FOR_FULL_SCORE = 5
+
+# Statements of at least this many spoken words count as substantive
+SUBSTANTIVE_STATEMENT_WORDS = 50
+
+
+@dataclass
+class PerformanceBreakdown:
+    """Each component's weighted contribution to a performance score."""
+    attendance: float
+    bills: float
+    quality: float
+    total: float
+
+
+def _clamp(value: float) -> float:
+    """Clamp a component score to the 0-100 range."""
+    return max(0.0, min(100.0, value))
+
+
+def bills_score(bills_sponsored: int) -> float:
+    """
+    Convert a number of sponsored bills into a 0-100 score.
+
+    Args:
+        bills_sponsored: Number of bills the MP sponsored
+
+    Returns:
+        Score rising linearly to 100 at BILLS_FOR_FULL_SCORE bills
+    """
+    return _clamp(100.0 * bills_sponsored / BILLS_FOR_FULL_SCORE)
+
+
+def quality_score(statements: List[Statement]) -> float:
+    """
+    Score the substance of an MP's contributions.
+
+    Args:
+        statements: Statements made by a single MP
+
+    Returns:
+        Percentage of statements with at least SUBSTANTIVE_STATEMENT_WORDS
+        spoken words (0.0 if the MP made no statements)
+    """
+    if not statements:
+        return 0.0
+
+    substantive = sum(
+        1 for statement in statements
+        if count_words(statement.text) >= SUBSTANTIVE_STATEMENT_WORDS
+    )
+    return 100.0 * substantive / len(statements)
+
+
+def calculate_performance_score(attendance: float, bills: float, quality: float) -> float:
+    """
+    Combine component scores into an overall performance score.
+
+    Components outside 0-100 are clamped into range before weighting; use
+    validate_performance_inputs to reject them instead.
+
+    Args:
+        attendance: Attendance score (0-100)
+        bills: Bills score (0-100)
+        quality: Quality score (0-100)
+
+    Returns:
+        Weighted performance score between 0 and 100
+    """
+    return (
+        ATTENDANCE_WEIGHT * _clamp(attendance)
+        + BILLS_WEIGHT * _clamp(bills)
+        + QUALITY_WEIGHT * _clamp(quality)
+    )
+
+
+def performance_breakdown(attendance: float, bills: float, quality: float) -> PerformanceBreakdown:
+    """
+    Show how each component contributes to the performance score.
+
+    Args:
+        attendance: Attendance score (0-100)
+        bills: Bills score (0-100)
+        quality: Quality score (0-100)
+
+    Returns:
+        PerformanceBreakdown whose contributions sum to the total given by
+        calculate_performance_score
+    """
+    breakdown = PerformanceBreakdown(
+        attendance=ATTENDANCE_WEIGHT * _clamp(attendance),
+        bills=BILLS_WEIGHT * _clamp(bills),
+        quality=QUALITY_WEIGHT * _clamp(quality),
+        total=0.0
+    )
+    breakdown.total = breakdown.attendance + breakdown.bills + breakdown.quality
+    return breakdown
+
+
+def population_weighted_performance(
+    mps: List[Dict],
+    scores: Dict[object, float],
+    population: Dict[str, int]
+) -> Optional[float]:
+    """
+    Average MP performance weighted by constituency population.
+
+    The result is the score of the MP representing the average Kenyan
+    rather than the average MP. Constituency names are canonicalized on
+    both sides before lookup. MPs are skipped when they have no score, no
+    constituency (nominated members), or a constituency missing from
+    population or with a non-positive population; they neither count
+    towards nor dilute the average.
+
+    Args:
+        mps: MP dictionaries with 'id' and 'constituency' keys
+        scores: Mapping of MP id to performance score
+        population: Mapping of constituency name to population
+
+    Returns:
+        Weighted average score, or None if no MP could be weighted
+    """
+    normalizer = ConstituencyNormalizer()
+    population_by_key = {
+        normalizer.canonicalize(name): people for name, people in population.items()
+    }
+
+    weighted_total = 0.0
+    total_population = 0
+
+    for mp in mps:
+        score = scores.get(mp.get('id'))
+        constituency = mp.get('constituency')
+        if score is None or not constituency:
+            continue
+
+        people = population_by_key.get(normalizer.canonicalize(constituency))
+        if not people or people <= 0:
+            continue
+
+        weighted_total += score * people
+        total_population += people
+
+    if total_population == 0:
+        return None
+
+    return weighted_total / total_population
+
+
+def rank_within_party(mps: List[Dict], scores: Dict[object, float]) -> Dict[object, int]:
+    """
+    Rank MPs by performance score among members of their own party.
+
+    Ranks are 1-based with the highest score first. Tied scores (equal
+    within FLOAT_EPSILON) share a rank and the next rank is skipped
+    (1, 1, 3). Each MP's current party is used. MPs with no score or no
+    known party are omitted.
+
+    Args:
+        mps: MP dictionaries with 'id' and party information
+        scores: Mapping of MP id to performance score
+
+    Returns:
+        Mapping of MP id to rank within their party
+    """
+    by_party: Dict[str, List[Tuple[float, object]]] = defaultdict(list)
+    for mp in mps:
+        score = scores.get(mp.get('id'))
+        party = current_party(mp)
+        if score is None or not party:
+            continue
+        by_party[party].append((score, mp['id']))
+
+    ranks: Dict[object, int] = {}
+    for members in by_party.values():
+        members.sort(key=lambda member: member[0], reverse=True)
+        for position, (score, mp_id) in enumerate(members, start=1):
+            if position > 1 and float_equal(score, members[position - 2][0]):
+                ranks[mp_id] = ranks[members[position - 2][1]]
+            else:
+                ranks[mp_id] = position
+
+    return ranks
+
+
+def median_performance(scores: Dict[object, float]) -> float:
+    """
+    Median performance score, which unlike the mean is not pulled by a few
+    outlying MPs.
+
+    With an even number of scores the two middle values are averaged.
+
+    Args:
+        scores: Mapping of MP id to performance score
+
+    Returns:
+        Median score
+
+    Raises:
+        ValueError: If there are no scores
+    """
+    if not scores:
+        raise ValueError("Cannot take the median of no performance scores")
+
+    ordered = sorted(scores.values())
+    middle = len(ordered) // 2
+    if len(ordered) % 2:
+        return ordered[middle]
+    return (ordered[middle - 1] + ordered[middle]) / 2
@@ -0,0 +1,84 @@
+#!/usr/bin/env python3
+"""
+Petition response metrics.
+
+Petition records are dictionaries with an 'id' and the 'presented' date
+(YYYY-MM-DD or a date). Responses, usually a committee report or a Cabinet
+Secretary's statement, are dictionaries with the 'petition_id' they answer
+and their 'date'.
+
+Usage:
+    from hansard_tales.analysis.petitions import average_latency, petition_response_latency
+
+    latency, pending = petition_response_latency(petitions, responses)
+    typical_wait = average_latency(latency)
+"""
+from datetime import date, timedelta
+from typing import Dict, List, Optional, Tuple, Union
+
+
+def _as_date(value: Union[str, date]) -> date:
+    """Parse a YYYY-MM-DD string, passing dates through."""
+    if isinstance(value, date):
+        return value
+    return date.fromisoformat(value)
+
+
+def petition_response_latency(
+    petitions: List[Dict],
+    responses: List[Dict]
+) -> Tuple[Dict[object, timedelta], List[object]]:
+    """
+    Measure how long each petition waited for a response.
+
+    A petition's latency runs from its presentation to its earliest
+    response. Responses dated before the petition was presented are data
+    errors and are ignored.
+
+    Args:
+        petitions: Petition dictionaries with 'id' and 'presented'
+        responses: Response dictionaries with 'petition_id' and 'date'
+
+    Returns:
+        Tuple of (latency by petition id, ids of petitions with no response
+        in input order)
+    """
+    first_response: Dict[object, date] = {}
+    presented = {petition['id']: _as_date(petition['presented']) for petition in petitions}
+
+    for response in responses:
+        petition_id = response.get('petition_id')
+        if petition_id not in presented:
+            continue
+
+        responded = _as_date(response['date'])
+        if responded < presented[petition_id]:
+            continue
+
+        if petition_id not in first_response or responded < first_response[petition_id]:
+            first_response[petition_id] = responded
+
+    latency = {
+        petition_id: first_response[petition_id] - presented_on
+        for petition_id, presented_on in presented.items()
+        if petition_id in first_response
+    }
+    pending = [petition['id'] for petition in petitions if petition['id'] not in first_response]
+
+    return latency, pending
+
+
+def average_latency(latency: Dict[object, timedelta]) -> Optional[timedelta]:
+    """
+    Average the response latency of answered petitions.
+
+    Args:
+        latency: Latency by petition id, from petition_response_latency
+
+    Returns:
+        Mean latency, or None if no petition has been answered
+    """
+    if not latency:
+        return None
+
+    return sum(latency.values(), timedelta()) / len(latency)
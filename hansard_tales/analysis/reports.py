@@ -0,0 +1,95 @@
+#!/usr/bin/env python3
+"""
+Per-MP reports combining every metric in one record.
+
+Per-MP inputs are keyed by MP id (mp['id']): statements attributed to the
+MP, and attendance as a percentage of sittings. Bills are dictionaries with
+a 'sponsor_mp_id' key. The score is calculate_performance_score, whose
+component weights are explained in the performance module.
+
+Usage:
+    from hansard_tales.analysis.reports import build_mp_reports
+
+    reports = build_mp_reports(mps, statements_by_mp, bills, attendance)
+"""
+from dataclasses import dataclass, field
+from typing import Dict, List
+
+from hansard_tales.analysis.performance import (
+    bills_score,
+    calculate_performance_score,
+    quality_score,
+)
+from hansard_tales.analysis.speech_metrics import SpeechStats, accumulate_speech_stats
+from hansard_tales.analysis.topics import top_topics_for_mp
+from hansard_tales.processors.mp_identifier import Statement
+
+
+# Number of topics listed on each report
+REPORT_TOP_TOPICS = 5
+
+
+@dataclass
+class MPReport:
+    """All metrics for a single MP."""
+    mp: Dict
+    score: float
+    stats: SpeechStats
+    bills_sponsored: int = 0
+    top_topics: List[str] = field(default_factory=list)
+
+
+def build_mp_reports(
+    mps: List[Dict],
+    statements: Dict[object, List[Statement]],
+    bills: List[Dict],
+    attendance: Dict[object, float]
+) -> List[MPReport]:
+    """
+    Build a report for every MP.
+
+    MPs missing from statements or attendance are reported with no
+    statements and zero attendance rather than skipped.
+
+    Args:
+        mps: MP dictionaries with an 'id' key
+        statements: Mapping of MP id to the MP's statements
+        bills: Bill dictionaries
+        attendance: Mapping of MP id to attendance percentage (0-100)
+
+    Returns:
+        List of MPReport objects in the same order as mps
+    """
+    sponsored: Dict[object, int] = {}
+    for bill in bills:
+        sponsor = bill.get('sponsor_mp_id')
+        if sponsor is not None:
+            sponsored[sponsor] = sponsored.get(sponsor, 0) + 1
+
+    reports = []
+    for mp in mps:
+        mp_id = mp.get('id')
+        mp_statements = statements.get(mp_id, [])
+        bill_count = sponsored.get(mp_id, 0)
+
+        # Statements are already attributed to this MP, so spelling variants
+        # of their name are combined
+        per_name = accumulate_speech_stats(mp_statements).values()
+        stats = SpeechStats(
+            statement_count=sum(name_stats.statement_count for name_stats in per_name),
+            word_count=sum(name_stats.word_count for name_stats in per_name)
+        )
+
+        reports.append(MPReport(
+            mp=mp,
+            score=calculate_performance_score(
+                attendance.get(mp_id, 0.0),
+                bills_score(bill_count),
+                quality_score(mp_statements)
+            ),
+            stats=stats,
+            bills_sponsored=bill_count,
+            top_topics=top_topics_for_mp(mp_statements, top_n=REPORT_TOP_TOPICS)
+        ))
+
+    return reports
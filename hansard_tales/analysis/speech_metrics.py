@@ -0,0 +1,300 @@
+#!/usr/bin/env python3
+"""
+Length and participation metrics for MP statements.
+
+Hansard interleaves editorial annotations with speech, for example
+"(Applause)" or "[The Temporary Speaker (Hon. Omar) took the Chair]". These
+were never spoken, so every metric here counts words only after removing them.
+
+Usage:
+    from hansard_tales.analysis.speech_metrics import (
+        accumulate_speech_stats,
+        estimate_reading_time,
+        extract_editorial_notes,
+        readability_score,
+        speaking_share_over_term,
+        speaking_time_gini,
+    )
+
+    duration = estimate_reading_time(statement)
+    gini = speaking_time_gini(accumulate_speech_stats(statements))
+    shares = speaking_share_over_term({session_id: accumulate_speech_stats(statements)})
+    grade = readability_score(statement.text)
+    spoken, notes = extract_editorial_notes(page_text)
+"""
+import re
+from dataclasses import dataclass
+from datetime import timedelta
+from typing import Dict, List, Tuple
+
+from hansard_tales.processors.mp_identifier import Statement
+
+
+# Typical pace of parliamentary oratory
+DEFAULT_WORDS_PER_MINUTE = 150
+
+# Number of leading speakers whose share of words measures concentration
+TOP_SPEAKERS = 5
+
+# "(Applause)", "(Loud consultations)", "[Hon. Members: Hear! Hear!]"
+ANNOTATION_PATTERN = re.compile(r'\([^()]*\)|\[[^\[\]]*\]')
+
+# Sentence-ending punctuation, except after titles such as "Hon." and "No."
+SENTENCE_END_PATTERN = re.compile(
+    r'(?<!\bHon)(?<!\bMr)(?<!\bMrs)(?<!\bDr)(?<!\bProf)(?<!\bNo)[.!?]+(?=\s|$)'
+)
+
+# Runs of vowels approximate syllables ("par-lia-ment" has three)
+VOWEL_GROUP_PATTERN = re.compile(r'[aeiouy]+')
+
+
+@dataclass
+class SpeechStats:
+    """Aggregate speaking statistics for one MP."""
+    statement_count: int = 0
+    word_count: int = 0
+
+
+@dataclass
+class ParticipationMetrics:
+    """Summary of who took part in a sitting's debate."""
+    distinct_speakers: int = 0
+    total_statements: int = 0
+    top_speaker_share: float = 0.0
+
+
+def extract_editorial_notes(text: str) -> Tuple[str, List[str]]:
+    """
+    Separate bracketed editorial notes from the spoken text.
+
+    Notes such as "(Applause)" and "[Technical hitch]" are removed from the
+    text but returned so they can be kept for provenance. Line breaks in
+    the text are preserved.
+
+    Args:
+        text: Hansard or statement text
+
+    Returns:
+        Tuple of (text without notes, notes in order of appearance without
+        their brackets)
+    """
+    if not text:
+        return '', []
+
+    notes = [' '.join(match.group(0)[1:-1].split()) for match in ANNOTATION_PATTERN.finditer(text)]
+
+    cleaned = ANNOTATION_PATTERN.sub(' ', text)
+    cleaned = '\n'.join(' '.join(line.split()) for line in cleaned.split('\n'))
+
+    return cleaned.strip(), notes
+
+
+def strip_annotations(text: str) -> str:
+    """
+    Remove bracketed editorial annotations from statement text.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        Text with parenthesised and square-bracketed annotations removed
+    """
+    cleaned, _ = extract_editorial_notes(text)
+    return ' '.join(cleaned.split())
+
+
+def count_words(text: str) -> int:
+    """
+    Count spoken words in statement text, ignoring annotations.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        Number of whitespace-separated words
+    """
+    return len(strip_annotations(text).split())
+
+
+def split_sentences(text: str) -> List[str]:
+    """
+    Split spoken text into sentences, ignoring annotations.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        Non-empty sentences, with their closing punctuation
+    """
+    spoken = strip_annotations(text)
+    sentences = []
+    start = 0
+
+    for match in SENTENCE_END_PATTERN.finditer(spoken):
+        sentences.append(spoken[start:match.end()].strip())
+        start = match.end()
+    sentences.append(spoken[start:].strip())
+
+    return [sentence for sentence in sentences if sentence]
+
+
+def count_syllables(word: str) -> int:
+    """
+    Estimate the number of syllables in a word.
+
+    Each run of vowels counts as one syllable, a silent final "e" (but not
+    "-le", as in "table") is discounted, and every word has at least one.
+
+    Args:
+        word: Single word
+
+    Returns:
+        Approximate syllable count (0 for a word without letters)
+    """
+    word = re.sub(r'[^a-z]', '', word.lower())
+    if not word:
+        return 0
+
+    syllables = len(VOWEL_GROUP_PATTERN.findall(word))
+    if word.endswith('e') and not word.endswith('le') and syllables > 1:
+        syllables -= 1
+
+    return max(syllables, 1)
+
+
+def readability_score(text: str) -> float:
+    """
+    Compute the Flesch-Kincaid grade level of spoken text.
+
+    The grade approximates the years of schooling needed to follow the
+    text. Sentences and words are counted after removing annotations, as in
+    count_words; syllables use the count_syllables heuristic.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        Grade level (0.0 for text with no words)
+    """
+    sentences = split_sentences(text)
+    words = [word for sentence in sentences for word in sentence.split() if count_syllables(word)]
+    if not words:
+        return 0.0
+
+    syllables = sum(count_syllables(word) for word in words)
+    return 0.39 * len(words) / len(sentences) + 11.8 * syllables / len(words) - 15.59
+
+
+def estimate_reading_time(
+    statement: Statement,
+    words_per_minute: int = DEFAULT_WORDS_PER_MINUTE
+) -> timedelta:
+    """
+    Estimate how long a statement takes to read aloud.
+
+    Args:
+        statement: Statement to measure
+        words_per_minute: Reading pace
+
+    Returns:
+        Estimated duration
+
+    Raises:
+        ValueError: If words_per_minute is not positive
+    """
+    if words_per_minute <= 0:
+        raise ValueError(f"words_per_minute must be positive, got {words_per_minute}")
+
+    return timedelta(minutes=count_words(statement.text) / words_per_minute)
+
+
+def accumulate_speech_stats(statements: List[Statement]) -> Dict[str, SpeechStats]:
+    """
+    Total statement and word counts per MP.
+
+    Args:
+        statements: Statements from one or more sessions
+
+    Returns:
+        Dictionary mapping MP name to their SpeechStats
+    """
+    per_mp: Dict[str, SpeechStats] = {}
+
+    for statement in statements:
+        stats = per_mp.setdefault(statement.mp_name, SpeechStats())
+        stats.statement_count += 1
+        stats.word_count += count_words(statement.text)
+
+    return per_mp
+
+
+def speaking_time_gini(per_mp: Dict[str, SpeechStats]) -> float:
+    """
+    Compute the Gini coefficient of word counts across MPs.
+
+    0.0 means every MP spoke equally; values towards 1.0 mean a few MPs
+    dominated debate. A single speaker, or no words at all, gives 0.0.
+
+    Args:
+        per_mp: Dictionary mapping MP name to SpeechStats
+
+    Returns:
+        Gini coefficient between 0 and 1
+    """
+    counts = sorted(stats.word_count for stats in per_mp.values())
+    n = len(counts)
+    total = sum(counts)
+
+    if n < 2 or total == 0:
+        return 0.0
+
+    weighted = sum((2 * i - n - 1) * count for i, count in enumerate(counts, start=1))
+    return weighted / (n * total)
+
+
+def speaking_share_over_term(per_session: Dict[object, Dict[str, SpeechStats]]) -> Dict[str, float]:
+    """
+    Each MP's share of all words spoken over a parliamentary term.
+
+    Args:
+        per_session: Mapping of session id to the per-MP SpeechStats from
+            accumulate_speech_stats for that session
+
+    Returns:
+        Dictionary mapping MP name to their share of the term's words, the
+        shares summing to 1.0; empty if no words were spoken
+    """
+    words: Dict[str, int] = {}
+    for per_mp in per_session.values():
+        for mp_name, stats in per_mp.items():
+            words[mp_name] = words.get(mp_name, 0) + stats.word_count
+
+    total = sum(words.values())
+    if total == 0:
+        return {}
+
+    return {mp_name: count / total for mp_name, count in words.items()}
+
+
+def participation_metrics(statements: List[Statement]) -> ParticipationMetrics:
+    """
+    Measure how widely debate was shared in a sitting.
+
+    Args:
+        statements: Statements from one sitting
+
+    Returns:
+        ParticipationMetrics with the number of distinct speakers, the
+        number of statements, and the share of all words spoken by the
+        TOP_SPEAKERS most talkative MPs (0.0 when no words were spoken)
+    """
+    per_mp = accumulate_speech_stats(statements)
+
+    counts = sorted((stats.word_count for stats in per_mp.values()), reverse=True)
+    total = sum(counts)
+
+    return ParticipationMetrics(
+        distinct_speakers=len(per_mp),
+        total_statements=len(statements),
+        top_speaker_share=sum(counts[:TOP_SPEAKERS]) / total if total else 0.0
+    )
@@ -0,0 +1,253 @@
+#!/usr/bin/env python3
+"""
+Keyword and topic analysis for MP statements.
+
+Keywords are the most frequent meaningful words in a statement once common
+English words and Hansard procedural vocabulary ("Speaker", "Member",
+"House", ...) are removed. Results are ordered deterministically so that
+pages built from them do not change between runs.
+
+Statements can also be classified into fixed policy areas using keyword
+lexicons for each category.
+
+Usage:
+    from hansard_tales.analysis.topics import classify_statement, top_topics_for_mp
+
+    topics = top_topics_for_mp(statements_by_mp['John Mbadi'], top_n=5)
+    categories = classify_statement(statement.text)
+    shares = topic_share_by_party(statements_by_mp, mps)
+"""
+import re
+from collections import Counter, defaultdict
+from typing import Dict, Iterable, List, Optional, Tuple
+
+from hansard_tales.mps import current_party
+from hansard_tales.processors.mp_identifier import Statement
+
+
+# Common English words plus parliamentary boilerplate that carries no topic
+STOPWORDS = {
+    'a', 'about', 'after', 'again', 'all', 'also', 'am', 'an', 'and', 'any',
+    'are', 'as', 'at', 'be', 'because', 'been', 'before', 'being', 'both',
+    'but', 'by', 'can', 'could', 'did', 'do', 'does', 'doing', 'done', 'for',
+    'from', 'further', 'had', 'has', 'have', 'having', 'he', 'her', 'here',
+    'him', 'his', 'how', 'i', 'if', 'in', 'into', 'is', 'it', 'its', 'just',
+    'know', 'let', 'like', 'made', 'make', 'many', 'may', 'me', 'more',
+    'most', 'much', 'must', 'my', 'no', 'not', 'now', 'of', 'on', 'one',
+    'only', 'or', 'other', 'our', 'out', 'over', 'own', 'people', 'said',
+    'same', 'say', 'she', 'should', 'so', 'some', 'such', 'than', 'that',
+    'the', 'their', 'them', 'then', 'there', 'these', 'they', 'this',
+    'those', 'through', 'to', 'too', 'under', 'up', 'us', 'very', 'want',
+    'was', 'we', 'were', 'what', 'when', 'where', 'which', 'while', 'who',
+    'why', 'will', 'with', 'would', 'yes', 'you', 'your',
+    # Hansard procedural vocabulary
+    'allow', 'chair', 'colleague', 'colleagues', 'hon', 'honourable',
+    'house', 'member', 'members', 'motion', 'order', 'point', 'rise',
+    'speaker', 'support', 'thank', 'time',
+}
+
+# Words of two letters or fewer are never treated as keywords
+MIN_KEYWORD_LENGTH = 3
+
+WORD_PATTERN = re.compile(r"[a-z][a-z']*")
+
+# Policy areas used by classify_statement
+CATEGORY_HEALTH = 'Health'
+CATEGORY_EDUCATION = 'Education'
+CATEGORY_SECURITY = 'Security'
+CATEGORY_AGRICULTURE = 'Agriculture'
+CATEGORY_ECONOMY = 'Economy'
+
+CATEGORIES = [
+    CATEGORY_HEALTH,
+    CATEGORY_EDUCATION,
+    CATEGORY_SECURITY,
+    CATEGORY_AGRICULTURE,
+    CATEGORY_ECONOMY,
+]
+
+# Seed terms for each category; replace with set_category_lexicon
+DEFAULT_CATEGORY_LEXICONS = {
+    CATEGORY_HEALTH: {
+        'clinic', 'clinics', 'disease', 'doctors', 'health', 'healthcare',
+        'hospital', 'hospitals', 'medical', 'medicine', 'nhif', 'nurses',
+        'patients', 'shif',
+    },
+    CATEGORY_EDUCATION: {
+        'bursaries', 'bursary', 'cbc', 'classrooms', 'education', 'helb',
+        'pupils', 'school', 'schools', 'students', 'teachers', 'tsc',
+        'universities', 'university',
+    },
+    CATEGORY_SECURITY: {
+        'bandits', 'banditry', 'crime', 'insecurity', 'kdf', 'officers',
+        'police', 'security', 'terrorism', 'terrorists',
+    },
+    CATEGORY_AGRICULTURE: {
+        'agriculture', 'coffee', 'crops', 'dairy', 'farmers', 'farming',
+        'fertiliser', 'fertilizer', 'irrigation', 'livestock', 'maize',
+        'tea',
+    },
+    CATEGORY_ECONOMY: {
+        'budget', 'debt', 'economy', 'employment', 'inflation', 'investment',
+        'jobs', 'kra', 'revenue', 'shillings', 'tax', 'taxes', 'trade',
+    },
+}
+
+_category_lexicons = {category: set(terms) for category, terms in DEFAULT_CATEGORY_LEXICONS.items()}
+
+# Lexicon hits needed before a statement is placed in a category
+MIN_CATEGORY_HITS = 2
+
+
+def tokenize(text: str) -> List[str]:
+    """
+    Split text into lowercase words.
+
+    Args:
+        text: Text to tokenize
+
+    Returns:
+        List of lowercase words with surrounding apostrophes removed
+    """
+    if not text:
+        return []
+
+    return [word.strip("'") for word in WORD_PATTERN.findall(text.lower())]
+
+
+def _rank(counts: Counter, top_n: Optional[int]) -> List[Tuple[str, int]]:
+    """Order counts by frequency, breaking ties alphabetically."""
+    ranked = sorted(counts.items(), key=lambda item: (-item[1], item[0]))
+    return ranked[:top_n] if top_n is not None else ranked
+
+
+def count_keywords(text: str) -> Counter:
+    """
+    Count non-stopword keywords in text.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        Counter mapping keyword to number of occurrences
+    """
+    return Counter(
+        word for word in tokenize(text)
+        if len(word) >= MIN_KEYWORD_LENGTH and word not in STOPWORDS
+    )
+
+
+def extract_keywords(text: str, top_n: Optional[int] = None) -> List[Tuple[str, int]]:
+    """
+    Extract the most frequent keywords from text.
+
+    Args:
+        text: Statement text
+        top_n: Maximum number of keywords to return (all if None)
+
+    Returns:
+        List of (keyword, count) tuples, most frequent first, ties alphabetical
+    """
+    return _rank(count_keywords(text), top_n)
+
+
+def top_topics_for_mp(statements: List[Statement], top_n: int = 5) -> List[str]:
+    """
+    Find an MP's most frequent topics across all of their statements.
+
+    Ties between equally frequent keywords are broken alphabetically so the
+    result is stable across runs.
+
+    Args:
+        statements: Statements made by a single MP
+        top_n: Maximum number of topics to return
+
+    Returns:
+        List of keywords, most frequent first
+    """
+    if top_n <= 0:
+        return []
+
+    counts = Counter()
+    for statement in statements:
+        counts.update(count_keywords(statement.text))
+
+    return [word for word, _ in _rank(counts, top_n)]
+
+
+def set_category_lexicon(category: str, terms: Iterable[str]) -> None:
+    """
+    Replace the lexicon used to recognise a category.
+
+    Args:
+        category: One of CATEGORIES
+        terms: Single-word terms (matched case-insensitively)
+
+    Raises:
+        KeyError: If the category is not one of CATEGORIES
+    """
+    if category not in _category_lexicons:
+        raise KeyError(f"Unknown category: {category}")
+
+    _category_lexicons[category] = {term.strip().lower() for term in terms if term.strip()}
+
+
+def classify_statement(text: str) -> List[str]:
+    """
+    Classify statement text into policy categories.
+
+    A category applies when at least MIN_CATEGORY_HITS words of the text
+    appear in its lexicon. A statement can belong to several categories.
+
+    Args:
+        text: Statement text
+
+    Returns:
+        Matching categories, in CATEGORIES order
+    """
+    counts = Counter(tokenize(text))
+
+    return [
+        category for category in CATEGORIES
+        if sum(counts[term] for term in _category_lexicons[category]) >= MIN_CATEGORY_HITS
+    ]
+
+
+def topic_share_by_party(
+    statements_by_mp: Dict[object, List[Statement]],
+    mps: List[Dict]
+) -> Dict[str, Dict[str, float]]:
+    """
+    Measure how much of each party's speaking is about each category.
+
+    Shares are normalized within each party - the fraction of the party's
+    statements placed in a category - so large and small parties can be
+    compared. A statement can fall in several categories (or none), so a
+    party's shares need not sum to 1. MPs without a current party are
+    skipped.
+
+    Args:
+        statements_by_mp: Mapping of MP id to their statements
+        mps: MP dictionaries with 'id' and party information
+
+    Returns:
+        Mapping of party to {category: share}, with every category in
+        CATEGORIES present for each party that has statements
+    """
+    totals: Counter = Counter()
+    hits: Dict[str, Counter] = defaultdict(Counter)
+
+    for mp in mps:
+        party = current_party(mp)
+        statements = statements_by_mp.get(mp.get('id'), [])
+        if not party or not statements:
+            continue
+
+        totals[party] += len(statements)
+        for statement in statements:
+            hits[party].update(classify_statement(statement.text))
+
+    return {
+        party: {category: hits[party][category] / total for category in CATEGORIES}
+        for party, total in totals.items()
+    }
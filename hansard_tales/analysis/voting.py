@@ -0,0 +1,124 @@
+#!/usr/bin/env python3
+"""
+Voting analysis for recorded divisions.
+
+Vote records are dictionaries with 'mp_id', 'bill_id' and 'position' keys.
+Positions are 'aye', 'no' or 'abstain' (case-insensitive; 'yes' and 'nay'
+are accepted as synonyms).
+
+Usage:
+    from hansard_tales.analysis.voting import party_cohesion
+
+    cohesion = party_cohesion(votes, mps, bill_id='Finance Bill 2024')
+    success = division_success_rate(mp_votes, outcomes)
+"""
+from collections import Counter, defaultdict
+from typing import Dict, List, Optional
+
+
+AYE = 'aye'
+NO = 'no'
+ABSTAIN = 'abstain'
+
+POSITION_ALIASES = {
+    'aye': AYE,
+    'ayes': AYE,
+    'yes': AYE,
+    'no': NO,
+    'noes': NO,
+    'nay': NO,
+    'abstain': ABSTAIN,
+    'abstained': ABSTAIN,
+    'abstention': ABSTAIN,
+}
+
+
+def normalize_position(position: Optional[str]) -> Optional[str]:
+    """
+    Normalize a recorded vote position.
+
+    Args:
+        position: Raw position (e.g., "Aye", "NO", "Abstained")
+
+    Returns:
+        AYE, NO or ABSTAIN, or None if the position is not recognised
+    """
+    if not position:
+        return None
+    return POSITION_ALIASES.get(position.strip().lower())
+
+
+def party_cohesion(votes: List[Dict], mps: List[Dict], bill_id) -> Dict[str, float]:
+    """
+    Compute each party's cohesion on a single bill.
+
+    Cohesion is the fraction of a party's voting members who voted with the
+    party majority. Abstentions and unrecognised positions are not counted
+    as votes, so they neither lower nor raise cohesion; a party whose only
+    voting member voted has cohesion 1.0. When a party splits evenly the
+    majority is either side, giving 0.5. Votes by MPs missing from mps, or
+    with no party, are ignored.
+
+    Args:
+        votes: Vote dictionaries
+        mps: MP dictionaries with 'id' and 'party' keys
+        bill_id: Bill whose division is analysed
+
+    Returns:
+        Mapping of party to cohesion between 0.5 and 1.0; parties with no
+        aye/no votes on the bill are omitted
+    """
+    party_by_mp = {mp.get('id'): mp.get('party') for mp in mps if mp.get('party')}
+
+    positions_by_party: Dict[str, Counter] = defaultdict(Counter)
+    for vote in votes:
+        if vote.get('bill_id') != bill_id:
+            continue
+
+        party = party_by_mp.get(vote.get('mp_id'))
+        position = normalize_position(vote.get('position'))
+
+        if party and position in (AYE, NO):
+            positions_by_party[party][position] += 1
+
+    cohesion = {}
+    for party, counts in positions_by_party.items():
+        total = sum(counts.values())
+        cohesion[party] = max(counts.values()) / total
+
+    return cohesion
+
+
+def division_success_rate(votes: List[Dict], outcomes: Dict[object, str]) -> Optional[float]:
+    """
+    Compute how often an MP voted with the prevailing side.
+
+    Abstentions, unrecognised positions and votes on bills with no known
+    outcome are excluded from the denominator.
+
+    Args:
+        votes: One MP's vote dictionaries
+        outcomes: Mapping of bill id to the prevailing position (AYE or NO,
+            or any alias accepted by normalize_position)
+
+    Returns:
+        Fraction of counted votes that matched the outcome, or None if the
+        MP cast no counted votes
+    """
+    matched = counted = 0
+
+    for vote in votes:
+        position = normalize_position(vote.get('position'))
+        outcome = normalize_position(outcomes.get(vote.get('bill_id')))
+
+        if position not in (AYE, NO) or outcome not in (AYE, NO):
+            continue
+
+        counted += 1
+        if position == outcome:
+            matched += 1
+
+    if not counted:
+        return None
+
+    return matched / counted
@@ -12,15 +12,18 @@ Usage:
     updater.process_hansard_pdf(pdf_path, pdf_url, date)
 """
 
+import json
 import logging
 import sqlite3
 from datetime import datetime
 from pathlib import Path
 from typing import Dict, List, Optional, Tuple
 
+from hansard_tales.database.init_db import migrate_schema
 from hansard_tales.processors.pdf_processor import PDFProcessor
 from hansard_tales.processors.mp_identifier import MPIdentifier, Statement
-from hansard_tales.processors.bill_extractor import BillExtractor
+from hansard_tales.processors.bill_extractor import BillExtractor, BillReference
+from hansard_tales.processors.sitting_parser import SittingParser
 
 
 # Configure logging
@@ -45,11 +48,24 @@ class DatabaseUpdater:
         self.pdf_processor = PDFProcessor()
         self.mp_identifier = MPIdentifier()
         self.bill_extractor = BillExtractor()
+        self.sitting_parser = SittingParser()
+        self._schema_migrated = False
     
     def get_connection(self) -> sqlite3.Connection:
-        """Get database connection."""
+        """
+        Get database connection.
+        
+        The first connection migrates the schema, so databases created by
+        an older release gain any tables and columns the updater writes.
+        """
         conn = sqlite3.Connection(self.db_path)
         conn.row_factory = sqlite3.Row
+        
+        if not self._schema_migrated:
+            for added in migrate_schema(conn):
+                logger.info(f"Added {added} to existing database")
+            self._schema_migrated = True
+        
         return conn
     
     def get_or_create_mp(
@@ -191,6 +207,48 @@ class DatabaseUpdater:
         
         return session_id
     
+    def update_session_volume(
+        self,
+        cursor: sqlite3.Cursor,
+        session_id: int,
+        volume: str,
+        number: str
+    ) -> None:
+        """
+        Record the Official Report volume and number for a session.
+        
+        Args:
+            cursor: Database cursor
+            session_id: Session ID
+            volume: Official Report volume (e.g., "III")
+            number: Official Report number within the volume
+        """
+        cursor.execute("""
+            UPDATE hansard_sessions 
+            SET volume = ?, number = ? 
+            WHERE id = ?
+        """, (volume, number, session_id))
+    
+    def update_session_officers(
+        self,
+        cursor: sqlite3.Cursor,
+        session_id: int,
+        officers: Dict[str, str]
+    ) -> None:
+        """
+        Record the officers of the House named in a session, for provenance.
+        
+        Args:
+            cursor: Database cursor
+            session_id: Session ID
+            officers: Mapping of office to officer name (stored as JSON)
+        """
+        cursor.execute("""
+            UPDATE hansard_sessions 
+            SET officers = ? 
+            WHERE id = ?
+        """, (json.dumps(officers, sort_keys=True), session_id))
+    
     def insert_statement(
         self,
         cursor: sqlite3.Cursor,
@@ -228,6 +286,37 @@ class DatabaseUpdater:
         
         return statement_id
     
+    def upsert_bill(
+        self,
+        cursor: sqlite3.Cursor,
+        bill: BillReference
+    ) -> int:
+        """
+        Record a bill's Money Bill flag and assent date.
+        
+        A bill already recorded keeps its flag once set and its assent
+        date unless this reference gives one.
+        
+        Args:
+            cursor: Database cursor
+            bill: BillReference object
+            
+        Returns:
+            Bill ID
+        """
+        reference = self.bill_extractor.format_bill_reference(bill)
+        
+        cursor.execute("""
+            INSERT INTO bills (reference, money_bill, assented_at)
+            VALUES (?, ?, ?)
+            ON CONFLICT(reference) DO UPDATE SET
+                money_bill = money_bill OR excluded.money_bill,
+                assented_at = COALESCE(excluded.assented_at, assented_at)
+        """, (reference, bill.money_bill, bill.assented_at))
+        
+        cursor.execute("SELECT id FROM bills WHERE reference = ?", (reference,))
+        return cursor.fetchone()['id']
+    
     def check_duplicate_session(
         self,
         cursor: sqlite3.Cursor,
@@ -344,6 +433,20 @@ class DatabaseUpdater:
                 cursor, date, title, pdf_url, pdf_path
             )
             
+            # Record volume/number from the document header for citations
+            pages = extracted_data['pages']
+            volume_info = self.sitting_parser.extract_volume_info(
+                pages[0].get('text', '') if pages else ''
+            )
+            if volume_info:
+                self.update_session_volume(cursor, session_id, *volume_info)
+            
+            officers = self.sitting_parser.extract_officers(
+                pages[0].get('text', '') if pages else ''
+            )
+            if officers:
+                self.update_session_officers(cursor, session_id, officers)
+            
             # Process each statement
             mp_count = 0
             statement_count = 0
@@ -370,6 +473,12 @@ class DatabaseUpdater:
                 
                 statement_count += 1
             
+            # Record bills from the whole sitting, so certifications and
+            # assent announced from the Chair are kept
+            full_text = "\n\n".join(page.get('text', '') for page in pages)
+            for bill in self.bill_extractor.extract_bill_references(full_text):
+                self.upsert_bill(cursor, bill)
+            
             # Mark session as processed
             self.mark_session_processed(cursor, session_id)
             
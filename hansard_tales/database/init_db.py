@@ -8,6 +8,10 @@ This script creates the SQLite database schema including:
 - mp_terms: Junction table linking MPs to parliamentary terms
 - hansard_sessions: Daily parliamentary sittings
 - statements: Individual MP statements in sessions
+- bills: Bills referred to in sittings, with Money Bill and assent details
+
+Existing databases are brought up to date by migrate_schema, which adds
+tables and columns introduced since the database was created.
 
 Usage:
     python scripts/init_db.py [--db-path PATH]
@@ -17,6 +21,39 @@ import argparse
 import sqlite3
 import sys
 from pathlib import Path
+from typing import List
+
+
+# Columns added to tables after their first release, as (table, column,
+# definition). CREATE TABLE IF NOT EXISTS leaves existing tables unchanged,
+# so migrate_schema adds these to older databases.
+ADDED_COLUMNS = [
+    ('mps', 'email', 'TEXT'),
+    ('mps', 'phone', 'TEXT'),
+    ('mps', 'membership_status', "TEXT DEFAULT 'active'"),
+    ('hansard_sessions', 'volume', 'TEXT'),
+    ('hansard_sessions', 'number', 'TEXT'),
+    ('hansard_sessions', 'officers', 'TEXT'),
+]
+
+# Bills table, keyed on the formatted reference statements store in
+# bill_reference (e.g. "Finance Bill 2024")
+BILLS_TABLE = """
+    CREATE TABLE IF NOT EXISTS bills (
+        id INTEGER PRIMARY KEY AUTOINCREMENT,
+        reference TEXT NOT NULL,
+        money_bill BOOLEAN DEFAULT 0,
+        assented_at DATE,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE(reference)
+    )
+"""
+
+# Tables added after the first release, created by migrate_schema in older
+# databases
+ADDED_TABLES = {
+    'bills': BILLS_TABLE,
+}
 
 
 def create_tables(conn: sqlite3.Connection) -> None:
@@ -44,6 +81,9 @@ def create_tables(conn: sqlite3.Connection) -> None:
             constituency TEXT NOT NULL,
             party TEXT,
             photo_url TEXT,
+            email TEXT,
+            phone TEXT,
+            membership_status TEXT DEFAULT 'active',
             first_elected_year INTEGER,
             created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
             updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
@@ -76,6 +116,9 @@ def create_tables(conn: sqlite3.Connection) -> None:
             title TEXT,
             pdf_url TEXT NOT NULL,
             pdf_path TEXT,
+            volume TEXT,
+            number TEXT,
+            officers TEXT,
             processed BOOLEAN DEFAULT 0,
             created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
             FOREIGN KEY (term_id) REFERENCES parliamentary_terms(id),
@@ -98,10 +141,52 @@ def create_tables(conn: sqlite3.Connection) -> None:
         )
     """)
     
+    # Bills table
+    cursor.execute(BILLS_TABLE)
+    
     conn.commit()
     print("✓ Created all tables")
 
 
+def migrate_schema(conn: sqlite3.Connection) -> List[str]:
+    """
+    Add tables and columns missing from an existing database.
+    
+    Safe to run on every connection: tables and columns already present
+    are left alone, as is an empty database (create_tables builds that).
+    
+    Args:
+        conn: Database connection
+        
+    Returns:
+        Added tables by name and added columns as "table.column"
+    """
+    cursor = conn.cursor()
+    added = []
+    
+    cursor.execute("SELECT name FROM sqlite_master WHERE type='table'")
+    tables = {row[0] for row in cursor.fetchall()}
+    if not tables:
+        return added
+    
+    for table, definition in ADDED_TABLES.items():
+        if table not in tables:
+            cursor.execute(definition)
+            added.append(table)
+    
+    for table, column, definition in ADDED_COLUMNS:
+        cursor.execute(f"PRAGMA table_info({table})")
+        columns = {row[1] for row in cursor.fetchall()}
+        if not columns or column in columns:
+            continue
+        
+        cursor.execute(f"ALTER TABLE {table} ADD COLUMN {column} {definition}")
+        added.append(f"{table}.{column}")
+    
+    conn.commit()
+    return added
+
+
 def create_indexes(conn: sqlite3.Connection) -> None:
     """Create database indexes for performance."""
     cursor = conn.cursor()
@@ -205,7 +290,7 @@ def verify_schema(conn: sqlite3.Connection) -> bool:
     # Check tables
     expected_tables = [
         'parliamentary_terms', 'mps', 'mp_terms', 
-        'hansard_sessions', 'statements'
+        'hansard_sessions', 'statements', 'bills'
     ]
     cursor.execute("SELECT name FROM sqlite_master WHERE type='table'")
     tables = [row[0] for row in cursor.fetchall()]
@@ -265,8 +350,9 @@ def initialize_database(db_path: str = "data/hansard.db") -> bool:
         conn = sqlite3.connect(db_path)
         print(f"Connected to database: {db_path}")
         
-        # Create schema
+        # Create schema, migrating tables from older releases
         create_tables(conn)
+        migrate_schema(conn)
         create_indexes(conn)
         create_views(conn)
         
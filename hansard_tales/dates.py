@@ -0,0 +1,369 @@
+#!/usr/bin/env python3
+"""
+Date parsing helpers shared by the scrapers and processors.
+
+Hansard titles and order papers write dates out in words, usually with an
+ordinal day ("Tuesday, 5th March 2024", "March 21st, 2024"). These helpers
+turn such text into YYYY-MM-DD strings.
+
+Text that looks like a date but is not a real one ("2025-13-45") raises
+InvalidDateError naming the offending text, so bad source data is not
+mistaken for a missing date.
+
+Usage:
+    from hansard_tales.dates import extract_date, strip_ordinal_suffix
+
+    strip_ordinal_suffix("21st")                  # "21"
+    extract_date("Tuesday, 5th March 2024")       # "2024-03-05"
+    extract_assent_date("assented to on 1st July, 2024")  # "2024-07-01"
+    extract_sitting_references("as I said yesterday", "2024-03-06")  # ["2024-03-05"]
+    parse_date_range("2025-01-01..2025-03-31")  # (date(2025, 1, 1), date(2025, 3, 31))
+"""
+import re
+from datetime import date, timedelta
+from typing import Iterator, List, Optional, Tuple, Union
+
+
+MONTHS = {
+    'january': 1, 'february': 2, 'march': 3, 'april': 4, 'may': 5,
+    'june': 6, 'july': 7, 'august': 8, 'september': 9, 'october': 10,
+    'november': 11, 'december': 12,
+}
+
+# DD/MM/YYYY or DD-MM-YYYY
+DAY_MONTH_YEAR_NUMERIC_PATTERN = re.compile(r'(\d{1,2})[/-](\d{1,2})[/-](\d{4})')
+
+# YYYY-MM-DD
+ISO_DATE_PATTERN = re.compile(r'(\d{4})-(\d{1,2})-(\d{1,2})')
+
+ORDINAL_PATTERN = re.compile(r'\b(\d+)(st|nd|rd|th)\b', re.IGNORECASE)
+
+_MONTH_NAMES = '|'.join(MONTHS)
+
+# "15th March, 2024"
+DAY_MONTH_YEAR_PATTERN = re.compile(
+    rf'\b(\d{{1,2}})\s+({_MONTH_NAMES})\s*,?\s+(\d{{4}})\b', re.IGNORECASE
+)
+
+# "March 15, 2024"
+MONTH_DAY_YEAR_PATTERN = re.compile(
+    rf'\b({_MONTH_NAMES})\s+(\d{{1,2}})\s*,?\s+(\d{{4}})\b', re.IGNORECASE
+)
+
+WEEKDAYS = ['monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday', 'sunday']
+
+# "as I said yesterday", "last week"
+RELATIVE_DAY_OFFSETS = {'yesterday': 1, 'last week': 7}
+RELATIVE_DAY_PATTERN = re.compile(r'\b(yesterday|last\s+week)\b', re.IGNORECASE)
+
+# "on Tuesday", "last Thursday" - but not "Tuesday, 5th March 2024"
+WEEKDAY_REFERENCE_PATTERN = re.compile(
+    rf'\b(?:on|last|previous)\s+({"|".join(WEEKDAYS)})\b'
+    rf'(?!\s*,?\s*(?:\d|(?:{_MONTH_NAMES})\b))',
+    re.IGNORECASE
+)
+
+# "... was assented to on 5th March, 2024"
+ASSENT_PATTERN = re.compile(r'\bassented\s+to\s+on\b', re.IGNORECASE)
+
+# How far after the assent phrase the date may appear, in characters
+# ("Tuesday, 21st September, 2024" is 30)
+ASSENT_DATE_WINDOW = 40
+
+# Separates the start and end of a date range: "2025-01-01..2025-03-31"
+DATE_RANGE_SEPARATOR = '..'
+
+
+class InvalidDateError(ValueError):
+    """Raised when text matches a date pattern but is not a real date."""
+
+    def __init__(self, text: str, reason: str):
+        """
+        Initialize the error.
+
+        Args:
+            text: The substring that looked like a date
+            reason: Why it is not a valid date
+        """
+        self.text = text
+        self.reason = reason
+        super().__init__(f"invalid date {text!r}: {reason}")
+
+
+def ordinal_suffix(number: int) -> str:
+    """
+    Get the English ordinal suffix for a number.
+
+    Args:
+        number: Non-negative integer
+
+    Returns:
+        'st', 'nd', 'rd' or 'th' (11, 12 and 13 take 'th')
+    """
+    if 11 <= number % 100 <= 13:
+        return 'th'
+    return {1: 'st', 2: 'nd', 3: 'rd'}.get(number % 10, 'th')
+
+
+def strip_ordinal_suffix(text: str) -> str:
+    """
+    Remove ordinal suffixes from numbers in text.
+
+    Only correct ordinals are changed: "21st" becomes "21" and "11th"
+    becomes "11", while "21th" or "11st" are left as they are.
+
+    Args:
+        text: Text possibly containing ordinals
+
+    Returns:
+        Text with ordinal suffixes removed
+    """
+    if not text:
+        return text
+
+    def replace(match: re.Match) -> str:
+        number, suffix = match.groups()
+        if suffix.lower() == ordinal_suffix(int(number)):
+            return number
+        return match.group(0)
+
+    return ORDINAL_PATTERN.sub(replace, text)
+
+
+# Candidate dates as (matched text, year, month, day)
+Candidate = Tuple[str, int, int, int]
+
+
+def _textual_candidates(text: str) -> Iterator[Candidate]:
+    """Yield dates written with month names, in order of appearance."""
+    text = strip_ordinal_suffix(text)
+
+    matches = []
+    for match in DAY_MONTH_YEAR_PATTERN.finditer(text):
+        day, month_name, year = match.groups()
+        matches.append((match.start(), match.group(0), year, month_name, day))
+
+    for match in MONTH_DAY_YEAR_PATTERN.finditer(text):
+        month_name, day, year = match.groups()
+        matches.append((match.start(), match.group(0), year, month_name, day))
+
+    for _, matched, year, month_name, day in sorted(matches):
+        yield matched, int(year), MONTHS[month_name.lower()], int(day)
+
+
+def _numeric_candidates(text: str) -> Iterator[Candidate]:
+    """Yield DD/MM/YYYY dates, then YYYY-MM-DD dates."""
+    for match in DAY_MONTH_YEAR_NUMERIC_PATTERN.finditer(text):
+        day, month, year = match.groups()
+        yield match.group(0), int(year), int(month), int(day)
+
+    for match in ISO_DATE_PATTERN.finditer(text):
+        year, month, day = match.groups()
+        yield match.group(0), int(year), int(month), int(day)
+
+
+def _first_valid(candidates: Iterator[Candidate]) -> Optional[str]:
+    """
+    Return the first candidate that is a real date.
+
+    Raises:
+        InvalidDateError: If there were candidates but none was valid
+    """
+    invalid: List[InvalidDateError] = []
+
+    for matched, year, month, day in candidates:
+        try:
+            return date(year, month, day).isoformat()
+        except ValueError as e:
+            invalid.append(InvalidDateError(matched, str(e)))
+
+    if invalid:
+        raise invalid[0]
+
+    return None
+
+
+def parse_textual_date(text: str) -> Optional[str]:
+    """
+    Find the first date written with a month name in text.
+
+    Both "15th March, 2024" and "March 15th, 2024" orders are recognised;
+    whichever appears first in the text is used.
+
+    Args:
+        text: Text to search
+
+    Returns:
+        Date string in YYYY-MM-DD format or None if no date is written
+
+    Raises:
+        InvalidDateError: If the only dates written are not real dates
+    """
+    if not text:
+        return None
+
+    return _first_valid(_textual_candidates(text))
+
+
+def extract_date(text: str) -> Optional[str]:
+    """
+    Extract the first valid date from text.
+
+    Formats are tried in order: DD/MM/YYYY (or with dashes), YYYY-MM-DD,
+    then dates written with month names. Something that only looks like a
+    date ("31/02/2024") is skipped in favour of a later valid date.
+
+    Args:
+        text: Text to search for dates
+
+    Returns:
+        Date string in YYYY-MM-DD format or None if nothing resembles a date
+
+    Raises:
+        InvalidDateError: If text contains date-like substrings but none of
+            them is a real date
+    """
+    if not text:
+        return None
+
+    def candidates() -> Iterator[Candidate]:
+        yield from _numeric_candidates(text)
+        yield from _textual_candidates(text)
+
+    return _first_valid(candidates())
+
+
+def extract_assent_date(text: str) -> Optional[str]:
+    """
+    Extract the date a Bill was assented to.
+
+    Only a date immediately following "assented to on" is used, so other
+    dates mentioned alongside (such as the sitting date or the date of
+    publication) are not mistaken for it.
+
+    Args:
+        text: Text announcing assent
+
+    Returns:
+        Date string in YYYY-MM-DD format or None if no assent date is given
+
+    Raises:
+        InvalidDateError: If the text after the assent phrase looks like a
+            date but is not a real one
+    """
+    if not text:
+        return None
+
+    for match in ASSENT_PATTERN.finditer(text):
+        window = text[match.end():match.end() + ASSENT_DATE_WINDOW]
+        assented = extract_date(window)
+        if assented:
+            return assented
+
+    return None
+
+
+def extract_sitting_references(
+    text: str,
+    session_date: Optional[Union[str, date]] = None
+) -> List[str]:
+    """
+    Find the dates of other sittings referred to in a statement.
+
+    Written dates ("5th March 2024", "05/03/2024") are always resolved;
+    ones that are not real dates are skipped. Relative references
+    ("yesterday", "last week", "on Tuesday") are resolved against
+    session_date and ignored without it. A weekday means its most recent
+    occurrence before the sitting. The sitting's own date is not reported.
+
+    Args:
+        text: Statement text
+        session_date: Date of the sitting the text is from, as YYYY-MM-DD
+            or a date
+
+    Returns:
+        Referenced dates in YYYY-MM-DD format, unique and in date order
+    """
+    if not text:
+        return []
+
+    referenced = set()
+
+    for _, year, month, day in list(_numeric_candidates(text)) + list(_textual_candidates(text)):
+        try:
+            referenced.add(date(year, month, day))
+        except ValueError:
+            continue
+
+    if isinstance(session_date, str):
+        session_date = date.fromisoformat(session_date)
+
+    if session_date is not None:
+        for match in RELATIVE_DAY_PATTERN.finditer(text):
+            phrase = ' '.join(match.group(1).lower().split())
+            referenced.add(session_date - timedelta(days=RELATIVE_DAY_OFFSETS[phrase]))
+
+        for match in WEEKDAY_REFERENCE_PATTERN.finditer(text):
+            weekday = WEEKDAYS.index(match.group(1).lower())
+            days_back = (session_date.weekday() - weekday) % 7 or 7
+            referenced.add(session_date - timedelta(days=days_back))
+
+        referenced.discard(session_date)
+
+    return [day.isoformat() for day in sorted(referenced)]
+
+
+def _parse_whole_date(text: str) -> Optional[str]:
+    """
+    Parse text that is a single date and nothing else.
+
+    Raises:
+        InvalidDateError: If the text looks like a date but is not a real one
+    """
+    whole = strip_ordinal_suffix(text)
+
+    def candidates() -> Iterator[Candidate]:
+        for candidate in _numeric_candidates(text):
+            if candidate[0] == text:
+                yield candidate
+        for candidate in _textual_candidates(text):
+            if candidate[0] == whole:
+                yield candidate
+
+    return _first_valid(candidates())
+
+
+def parse_date_range(text: str) -> Tuple[date, date]:
+    """
+    Parse a "start..end" date range, as used in query parameters.
+
+    Each end must be a date and nothing else, in any format extract_date
+    understands, so "2025-01-01..2025-03-31" and "1st January 2025..31st
+    March 2025" are equivalent but "see 2025-01-01..2025-03-31" is
+    rejected. Both ends are inclusive.
+
+    Args:
+        text: Date range
+
+    Returns:
+        Tuple of (start, end) dates
+
+    Raises:
+        ValueError: If the text is not two dates separated by "..", or the
+            start is after the end
+        InvalidDateError: If either end is not a real date
+    """
+    parts = (text or '').split(DATE_RANGE_SEPARATOR)
+    if len(parts) != 2:
+        raise ValueError(f"date range {text!r} must be two dates separated by {DATE_RANGE_SEPARATOR!r}")
+
+    start_text, end_text = (part.strip() for part in parts)
+    start, end = _parse_whole_date(start_text), _parse_whole_date(end_text)
+
+    if start is None or end is None:
+        raise ValueError(f"date range {text!r} must have a date at each end and nothing else")
+
+    if start > end:
+        raise ValueError(f"date range {text!r} starts after it ends")
+
+    return date.fromisoformat(start), date.fromisoformat(end)
@@ -0,0 +1,152 @@
+#!/usr/bin/env python3
+"""
+Exporters for MP data, performance scorecards, open-data bundles and video
+captions.
+
+All CSV output produced by the project goes through write_csv so that
+quoting and encoding behave the same for every published file. Full data
+releases are written as a bundle: newline-delimited JSON in which each line
+is one record tagged with its type, read back by importers.read_bundle.
+
+Usage:
+    from hansard_tales.exporters import write_bundle, write_scorecard_csv, write_webvtt
+
+    with open('scorecards.csv', 'w', encoding='utf-8', newline='') as f:
+        write_scorecard_csv(f, mps, scores)
+
+    with open('release.jsonl', 'w', encoding='utf-8') as f:
+        write_bundle(f, mps, sessions, bills)
+
+    with open('sitting.vtt', 'w', encoding='utf-8') as f:
+        write_webvtt(f, align_statements_to_chapters(statements, chapters))
+"""
+import csv
+import html
+import json
+from datetime import timedelta
+from typing import Dict, Iterable, List, TextIO
+
+from hansard_tales.statements import TimedStatement
+
+
+SCORECARD_FIELDS = ['id', 'name', 'constituency', 'party', 'score']
+
+# Record types in a bundle
+BUNDLE_MP = 'mp'
+BUNDLE_SESSION = 'session'
+BUNDLE_BILL = 'bill'
+
+# Shortest time a caption stays on screen
+MIN_CUE_DURATION = timedelta(seconds=1)
+
+
+def write_csv(f: TextIO, fieldnames: List[str], rows: Iterable[Dict]) -> None:
+    """
+    Write dictionaries as CSV rows with a header.
+
+    Keys missing from a row are written as empty cells and keys not listed
+    in fieldnames are ignored.
+
+    Args:
+        f: Text stream opened with newline=''
+        fieldnames: Column names in output order
+        rows: Row dictionaries
+    """
+    writer = csv.DictWriter(f, fieldnames=fieldnames, restval='', extrasaction='ignore')
+    writer.writeheader()
+    writer.writerows(rows)
+
+
+def write_scorecard_csv(f: TextIO, mps: List[Dict], scores: Dict) -> None:
+    """
+    Write MP performance scorecards as CSV.
+
+    Columns are id, name, constituency, party and score. The score is looked
+    up by MP id and left blank when the MP has no score.
+
+    Args:
+        f: Text stream opened with newline=''
+        mps: List of MP dictionaries
+        scores: Mapping of MP id to performance score
+    """
+    rows = []
+    for mp in mps:
+        score = scores.get(mp.get('id'))
+        rows.append({
+            'id': mp.get('id'),
+            'name': mp.get('name'),
+            'constituency': mp.get('constituency'),
+            'party': mp.get('party'),
+            'score': f'{score:.2f}' if score is not None else ''
+        })
+
+    write_csv(f, SCORECARD_FIELDS, rows)
+
+
+def write_bundle(f: TextIO, mps: List[Dict], sessions: List[Dict], bills: List[Dict]) -> None:
+    """
+    Write MPs, sessions and bills as a single JSONL bundle.
+
+    Each line is {"type": ..., "record": ...} with type BUNDLE_MP,
+    BUNDLE_SESSION or BUNDLE_BILL. MPs come first, then sessions, then
+    bills, each in input order. Values JSON cannot represent, such as
+    dates, are written as strings.
+
+    Args:
+        f: Text stream
+        mps: MP dictionaries
+        sessions: Session dictionaries
+        bills: Bill dictionaries
+    """
+    for record_type, records in ((BUNDLE_MP, mps), (BUNDLE_SESSION, sessions), (BUNDLE_BILL, bills)):
+        for record in records:
+            line = json.dumps(
+                {'type': record_type, 'record': record},
+                ensure_ascii=False,
+                sort_keys=True,
+                default=str
+            )
+            f.write(line + '\n')
+
+
+def _vtt_timestamp(offset: timedelta) -> str:
+    """Format an offset as a WebVTT timestamp (HH:MM:SS.mmm)."""
+    milliseconds = max(round(offset.total_seconds() * 1000), 0)
+    hours, milliseconds = divmod(milliseconds, 3_600_000)
+    minutes, milliseconds = divmod(milliseconds, 60_000)
+    seconds, milliseconds = divmod(milliseconds, 1000)
+    return f'{hours:02d}:{minutes:02d}:{seconds:02d}.{milliseconds:03d}'
+
+
+def write_webvtt(f: TextIO, timed: List[TimedStatement]) -> None:
+    """
+    Write timed statements as WebVTT captions for the sitting's video.
+
+    Each statement becomes one cue, voiced by its speaker. Cues are written
+    in start order. A cue starting at the same time as the one before it is
+    moved to MIN_CUE_DURATION after that cue's start. A cue shorter than
+    MIN_CUE_DURATION is lengthened, and a cue running into the next one is
+    ended where the next begins, so captions do not stack on screen.
+
+    Args:
+        f: Text stream
+        timed: Timed statements, from align_statements_to_chapters
+    """
+    cues = sorted(timed, key=lambda item: item.start)
+
+    starts: List[timedelta] = []
+    for item in cues:
+        start = max(item.start, timedelta(0))
+        if starts and start <= starts[-1]:
+            start = starts[-1] + MIN_CUE_DURATION
+        starts.append(start)
+
+    f.write('WEBVTT\n')
+    for i, (item, start) in enumerate(zip(cues, starts)):
+        end = max(item.end, start + MIN_CUE_DURATION)
+        if i + 1 < len(cues) and starts[i + 1] < end:
+            end = starts[i + 1]
+
+        voice = html.escape(' '.join(item.statement.mp_name.split()))
+        text = html.escape(' '.join(item.statement.text.split()), quote=False)
+        f.write(f'\n{_vtt_timestamp(start)} --> {_vtt_timestamp(end)}\n<v {voice}>{text}\n')
@@ -0,0 +1,138 @@
+#!/usr/bin/env python3
+"""
+Importers for MP data and open-data bundles.
+
+CSV rows are read one at a time, so files with tens of thousands of rows
+are never held in memory. Each row is validated and handed to a callback
+along with any validation error, leaving the caller to decide whether a bad
+row should stop the import. Bundles written by exporters.write_bundle are
+read back whole with read_bundle.
+
+Usage:
+    from hansard_tales.importers import read_bundle, stream_mps_csv
+
+    def handle(mp, error):
+        if error:
+            logger.warning(f"Skipping row: {error}")
+        else:
+            importer.get_or_create_mp(mp)
+
+    with open('mps.csv', encoding='utf-8', newline='') as f:
+        stream_mps_csv(f, handle)
+
+    with open('release.jsonl', encoding='utf-8') as f:
+        mps, sessions, bills = read_bundle(f)
+"""
+import csv
+import json
+from typing import Callable, Dict, List, Optional, TextIO, Tuple
+
+from hansard_tales.exporters import BUNDLE_BILL, BUNDLE_MP, BUNDLE_SESSION
+from hansard_tales.validation import ValidationError, validate_mp
+
+
+# Columns that must appear in the header
+REQUIRED_MP_COLUMNS = ['name', 'constituency']
+
+
+def stream_mps_csv(
+    f: TextIO,
+    fn: Callable[[Dict, Optional[ValidationError]], None]
+) -> int:
+    """
+    Read MP records from CSV one row at a time.
+
+    Cell values are stripped and empty cells become None. Each row is
+    passed to fn as fn(mp, error), where error is a ValidationError whose
+    problems are prefixed with the CSV line number, or None for a valid
+    row. fn stops the import by raising; the exception propagates to the
+    caller.
+
+    Args:
+        f: Text stream opened with newline=''
+        fn: Callback invoked for every row
+
+    Returns:
+        Number of rows read
+
+    Raises:
+        ValidationError: If the header lacks a required column
+    """
+    reader = csv.DictReader(f)
+
+    missing = [column for column in REQUIRED_MP_COLUMNS if column not in (reader.fieldnames or [])]
+    if missing:
+        raise ValidationError([f"missing column: {column}" for column in missing])
+
+    rows = 0
+    for row in reader:
+        rows += 1
+
+        mp = {
+            key: (value.strip() or None) if isinstance(value, str) else value
+            for key, value in row.items()
+            if key is not None
+        }
+
+        problems = []
+        if None in row:
+            problems.append("row has more cells than the header")
+
+        try:
+            validate_mp(mp)
+        except ValidationError as e:
+            problems.extend(e.problems)
+
+        error = None
+        if problems:
+            error = ValidationError([f"line {reader.line_num}: {problem}" for problem in problems])
+
+        fn(mp, error)
+
+    return rows
+
+
+def read_bundle(f: TextIO) -> Tuple[List[Dict], List[Dict], List[Dict]]:
+    """
+    Read a JSONL bundle written by exporters.write_bundle.
+
+    Blank lines are ignored. Every line is checked before anything is
+    returned, so a bundle is imported either completely or not at all.
+
+    Args:
+        f: Text stream
+
+    Returns:
+        Tuple of (mps, sessions, bills), each in file order
+
+    Raises:
+        ValidationError: Listing every malformed line, by line number
+    """
+    records: Dict[str, List[Dict]] = {BUNDLE_MP: [], BUNDLE_SESSION: [], BUNDLE_BILL: []}
+    problems = []
+
+    for line_num, line in enumerate(f, start=1):
+        if not line.strip():
+            continue
+
+        try:
+            entry = json.loads(line)
+        except json.JSONDecodeError as e:
+            problems.append(f"line {line_num}: invalid JSON: {e.msg}")
+            continue
+
+        record_type = entry.get('type') if isinstance(entry, dict) else None
+        if record_type not in records:
+            problems.append(f"line {line_num}: unknown record type {record_type!r}")
+            continue
+
+        if not isinstance(entry.get('record'), dict):
+            problems.append(f"line {line_num}: record is not an object")
+            continue
+
+        records[record_type].append(entry['record'])
+
+    if problems:
+        raise ValidationError(problems)
+
+    return records[BUNDLE_MP], records[BUNDLE_SESSION], records[BUNDLE_BILL]
@@ -0,0 +1,452 @@
+#!/usr/bin/env python3
+"""
+Utilities for working with MP records.
+
+MP records are dictionaries as produced by MPDataScraper or read from the
+mps table, with keys such as 'name', 'constituency' and 'party'. An MP who
+has changed parties may also carry 'party_history', a list of tenures:
+
+    {'party': 'Jubilee', 'from': '2017-08-31', 'to': '2022-05-20'}
+
+'from' and 'to' are YYYY-MM-DD dates (or date objects); a missing 'to' means
+the tenure is ongoing. The flat 'party' field is kept for records without
+history.
+
+'membership_status' records whether the member still holds the seat: one
+of MEMBERSHIP_ACTIVE, MEMBERSHIP_DECEASED, MEMBERSHIP_RESIGNED or
+MEMBERSHIP_UNSEATED. It is separate from the scraper's 'status', which says
+how the seat is held ("Elected" or "Nominated") and implies a sitting
+member. Records with neither are treated as active.
+
+Usage:
+    from hansard_tales.mps import current_party
+
+    party = current_party(mp, as_of=date(2021, 6, 1))
+    sitting_members = active_mps(mps)
+    missing, duplicates = check_roster_completeness(mps)
+    added, removed, changed = reconcile_mps(stored_mps, scraped_mps)
+    defections = detect_defections(stored_mps, scraped_mps)
+
+    repo = MPRepository()
+    repo.replace(scraper.scrape_all())
+    mp = repo.get(42)
+
+    svg = initials_avatar(mp)  # placeholder when mp['photo_url'] is empty
+"""
+import html
+import threading
+from collections import defaultdict
+from dataclasses import dataclass
+from datetime import date, datetime
+from typing import Dict, List, Optional, Tuple, Union
+
+from hansard_tales.processors.mp_identifier import MPIdentifier
+
+
+# Membership statuses; a by-election follows any status other than active
+MEMBERSHIP_ACTIVE = 'active'
+MEMBERSHIP_DECEASED = 'deceased'
+MEMBERSHIP_RESIGNED = 'resigned'
+MEMBERSHIP_UNSEATED = 'unseated'
+
+MEMBERSHIP_STATUSES = {MEMBERSHIP_ACTIVE, MEMBERSHIP_DECEASED, MEMBERSHIP_RESIGNED, MEMBERSHIP_UNSEATED}
+
+# Scraper 'status' values, by lowercase spelling; both mean a sitting member
+SEAT_TYPES = {'elected', 'nominated'}
+
+# Elected and nominated seats in the National Assembly: 290 constituencies,
+# 47 county Woman Representatives and 12 nominated Members
+NATIONAL_ASSEMBLY_SEATS = 349
+
+# Background colours for avatars, by upper-case party abbreviation
+PARTY_COLORS = {
+    'UDA': '#FDD835',
+    'ODM': '#F57C00',
+    'JUBILEE': '#C62828',
+    'WIPER': '#1565C0',
+    'WDM-K': '#1565C0',
+    'FORD-K': '#2E7D32',
+    'ANC': '#558B2F',
+    'KANU': '#212121',
+    'DAP-K': '#6A1B9A',
+}
+
+# Used for independents and parties without a colour
+DEFAULT_PARTY_COLOR = '#757575'
+
+# Width and height of generated avatars, in pixels
+AVATAR_SIZE = 64
+
+
+@dataclass
+class Defection:
+    """An MP's change of party between two versions of the roster."""
+    mp_id: object
+    from_party: str
+    to_party: str
+
+
+def _tenure_date(value: Union[str, date, None]) -> Optional[date]:
+    """Parse a tenure boundary into a date (None stays None)."""
+    if value is None or value == '':
+        return None
+    if isinstance(value, datetime):
+        return value.date()
+    if isinstance(value, date):
+        return value
+    return datetime.strptime(value, '%Y-%m-%d').date()
+
+
+def current_party(mp: Dict, as_of: Optional[Union[date, datetime]] = None) -> str:
+    """
+    Determine which party an MP belonged to on a given date.
+
+    When the record has a party history, the tenure covering as_of is used
+    (both ends inclusive). If no tenure covers the date the MP is treated as
+    having no party on it. Records without history fall back to the flat
+    'party' field.
+
+    Args:
+        mp: MP dictionary
+        as_of: Date to look up (default: today)
+
+    Returns:
+        Party name, or an empty string if unknown
+    """
+    history = mp.get('party_history')
+    if not history:
+        return mp.get('party') or ''
+
+    if as_of is None:
+        as_of = date.today()
+    elif isinstance(as_of, datetime):
+        as_of = as_of.date()
+
+    for tenure in history:
+        start = _tenure_date(tenure.get('from'))
+        end = _tenure_date(tenure.get('to'))
+
+        if (start is None or start <= as_of) and (end is None or as_of <= end):
+            return tenure.get('party') or ''
+
+    return ''
+
+
+def membership_status(mp: Dict) -> Optional[str]:
+    """
+    Find whether an MP still holds their seat.
+
+    Args:
+        mp: MP dictionary
+
+    Returns:
+        The lowercase 'membership_status'; MEMBERSHIP_ACTIVE for a scraped
+        record whose 'status' is a seat type in SEAT_TYPES; otherwise None
+    """
+    status = (mp.get('membership_status') or '').strip().lower()
+    if status:
+        return status
+
+    if (mp.get('status') or '').strip().lower() in SEAT_TYPES:
+        return MEMBERSHIP_ACTIVE
+
+    return None
+
+
+def active_mps(mps: List[Dict]) -> List[Dict]:
+    """
+    Select the MPs who currently hold their seats.
+
+    Former members (deceased, resigned or unseated) are excluded, so stats
+    about the current composition of the House are not skewed by seats
+    that changed hands in a by-election.
+
+    Args:
+        mps: MP dictionaries
+
+    Returns:
+        MPs whose membership status (see membership_status) is active or
+        unknown, in input order
+    """
+    return [
+        mp for mp in mps
+        if membership_status(mp) in (None, MEMBERSHIP_ACTIVE)
+    ]
+
+
+def find_duplicate_mp_ids(mps: List[Dict]) -> Dict[object, List[int]]:
+    """
+    Find MP ids that appear more than once.
+
+    Building an id-keyed mapping from records with repeated ids silently
+    keeps only the last one, so check with this first. Records without an
+    id are ignored.
+
+    Args:
+        mps: MP dictionaries
+
+    Returns:
+        Mapping of each repeated id to the list indices where it appears;
+        empty when every id is unique
+    """
+    positions: Dict[object, List[int]] = defaultdict(list)
+    for index, mp in enumerate(mps):
+        mp_id = mp.get('id')
+        if mp_id is not None:
+            positions[mp_id].append(index)
+
+    return {mp_id: indices for mp_id, indices in positions.items() if len(indices) > 1}
+
+
+def check_roster_completeness(
+    mps: List[Dict],
+    expected_seats: int = NATIONAL_ASSEMBLY_SEATS
+) -> Tuple[int, List[object]]:
+    """
+    Check a loaded roster fills every seat, to catch partial scrapes.
+
+    Each distinct id of an active member (see active_mps) fills one seat,
+    so former members and repeated records do not hide a gap. Records
+    straight from the scraper, with an "Elected" or "Nominated" status,
+    are active members.
+
+    Args:
+        mps: MP dictionaries
+        expected_seats: Number of seats the roster should fill
+
+    Returns:
+        Tuple of (number of unfilled seats, repeated ids in order of first
+        appearance)
+    """
+    filled = {mp['id'] for mp in active_mps(mps) if mp.get('id') is not None}
+    missing = max(expected_seats - len(filled), 0)
+
+    return missing, list(find_duplicate_mp_ids(mps))
+
+
+def diff_mp(old: Dict, new: Dict) -> Dict[str, Tuple[object, object]]:
+    """
+    Compare two versions of an MP record field by field.
+
+    A field missing from one record is compared as None.
+
+    Args:
+        old: Previous MP dictionary
+        new: Updated MP dictionary
+
+    Returns:
+        Mapping of each differing field to its (old, new) values; empty when
+        the records are identical
+    """
+    return {
+        field: (old.get(field), new.get(field))
+        for field in sorted(old.keys() | new.keys())
+        if old.get(field) != new.get(field)
+    }
+
+
+def reconcile_mps(
+    current: List[Dict],
+    incoming: List[Dict]
+) -> Tuple[List[Dict], List[Dict], List[Dict]]:
+    """
+    Compare a stored set of MPs with an updated roster.
+
+    Records are matched by 'id'; records without an id are ignored.
+
+    Args:
+        current: Stored MP dictionaries
+        incoming: MP dictionaries from the updated roster
+
+    Returns:
+        Tuple of (added, removed, changed). added and changed hold incoming
+        records in incoming order (changed being those for which diff_mp
+        reports a difference); removed holds current records in current
+        order
+    """
+    current_by_id = {mp['id']: mp for mp in current if mp.get('id') is not None}
+    incoming_ids = {mp['id'] for mp in incoming if mp.get('id') is not None}
+
+    added = []
+    changed = []
+    for mp in incoming:
+        mp_id = mp.get('id')
+        if mp_id is None:
+            continue
+
+        if mp_id not in current_by_id:
+            added.append(mp)
+        elif diff_mp(current_by_id[mp_id], mp):
+            changed.append(mp)
+
+    removed = [
+        mp for mp in current
+        if mp.get('id') is not None and mp['id'] not in incoming_ids
+    ]
+
+    return added, removed, changed
+
+
+def detect_defections(old: List[Dict], new: List[Dict]) -> List[Defection]:
+    """
+    Find MPs whose party changed between two versions of the roster.
+
+    Records are matched by 'id'. Each version's party is its current_party,
+    so a change recorded either in 'party' or in 'party_history' is found.
+    Parties are compared ignoring case and spacing, and an MP with no known
+    party in either version is not reported.
+
+    Args:
+        old: Previous MP dictionaries
+        new: Updated MP dictionaries
+
+    Returns:
+        List of Defection objects in the order of new
+    """
+    old_by_id = {mp['id']: mp for mp in old if mp.get('id') is not None}
+    defections = []
+
+    for mp in new:
+        previous = old_by_id.get(mp.get('id'))
+        if previous is None:
+            continue
+
+        from_party, to_party = current_party(previous), current_party(mp)
+        if not from_party or not to_party:
+            continue
+
+        if ' '.join(from_party.lower().split()) != ' '.join(to_party.lower().split()):
+            defections.append(Defection(mp_id=mp['id'], from_party=from_party, to_party=to_party))
+
+    return defections
+
+
+class MPRepository:
+    """
+    In-memory MP store that is safe to read while it is being refreshed.
+
+    replace builds the new list and id index before swapping them in under
+    a lock, so concurrent readers see either the old set or the new one and
+    never a half-built index.
+    """
+
+    def __init__(self, mps: Optional[List[Dict]] = None):
+        """
+        Initialize the repository.
+
+        Args:
+            mps: Optional initial MP dictionaries
+        """
+        self._lock = threading.Lock()
+        self._mps: List[Dict] = []
+        self._by_id: Dict[object, Dict] = {}
+        if mps:
+            self.replace(mps)
+
+    def get(self, mp_id: object) -> Optional[Dict]:
+        """
+        Look up an MP by id.
+
+        Args:
+            mp_id: MP id
+
+        Returns:
+            MP dictionary, or None if no MP has the id
+        """
+        with self._lock:
+            return self._by_id.get(mp_id)
+
+    def all(self) -> List[Dict]:
+        """
+        List every MP.
+
+        Returns:
+            New list of MP dictionaries in the order they were supplied
+        """
+        with self._lock:
+            return list(self._mps)
+
+    def replace(self, mps: List[Dict]) -> None:
+        """
+        Swap in a new set of MPs.
+
+        Records without an id are listed by all() but cannot be looked up;
+        when ids repeat, get() returns the last record with the id (see
+        find_duplicate_mp_ids).
+
+        Args:
+            mps: MP dictionaries
+        """
+        new_mps = list(mps)
+        new_by_id = {mp['id']: mp for mp in new_mps if mp.get('id') is not None}
+
+        with self._lock:
+            self._mps = new_mps
+            self._by_id = new_by_id
+
+
+def party_color(party: Optional[str]) -> str:
+    """
+    Look up the colour used for a party.
+
+    Args:
+        party: Party name or abbreviation, in any casing
+
+    Returns:
+        Hex colour such as "#F57C00"; DEFAULT_PARTY_COLOR if the party has
+        no colour
+    """
+    return PARTY_COLORS.get(' '.join((party or '').upper().split()), DEFAULT_PARTY_COLOR)
+
+
+def _text_color(background: str) -> str:
+    """Pick dark or white text for legibility on a hex background colour."""
+    red, green, blue = (int(background[i:i + 2], 16) for i in (1, 3, 5))
+    luminance = (0.299 * red + 0.587 * green + 0.114 * blue) / 255
+    return '#212121' if luminance > 0.6 else '#FFFFFF'
+
+
+def initials_avatar(mp: Dict) -> str:
+    """
+    Generate a placeholder avatar for an MP without a photo.
+
+    The avatar shows the MP's initials (first given name and surname, from
+    MPIdentifier.split_name, ignoring titles and name particles) on their
+    current party's colour. The output depends only on the record, so the
+    same MP always gets the same avatar.
+
+    Args:
+        mp: MP dictionary with 'name' and optionally party information
+
+    Returns:
+        SVG document as a string
+    """
+    identifier = MPIdentifier()
+    given, surname = identifier.split_name(mp.get('name') or '')
+
+    given_words = [
+        word for word in given.split()
+        if word.rstrip('.').lower() not in identifier.HONORIFICS
+    ]
+    surname_words = [
+        word for word in surname.split()
+        if word.lower() not in identifier.NAME_PARTICLES
+    ]
+    initials = ''.join(
+        words[index][0].upper()
+        for words, index in ((given_words, 0), (surname_words, -1))
+        if words
+    )
+
+    background = party_color(current_party(mp))
+    half = AVATAR_SIZE // 2
+
+    return (
+        f'<svg xmlns="http://www.w3.org/2000/svg" width="{AVATAR_SIZE}" height="{AVATAR_SIZE}" '
+        f'viewBox="0 0 {AVATAR_SIZE} {AVATAR_SIZE}" role="img" '
+        f'aria-label="{html.escape(mp.get("name") or "")}">'
+        f'<rect width="{AVATAR_SIZE}" height="{AVATAR_SIZE}" fill="{background}"/>'
+        f'<text x="{half}" y="{half}" dominant-baseline="central" text-anchor="middle" '
+        f'font-family="sans-serif" font-size="{AVATAR_SIZE * 2 // 5}" fill="{_text_color(background)}">'
+        f'{html.escape(initials)}</text></svg>'
+    )
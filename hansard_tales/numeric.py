@@ -0,0 +1,38 @@
+#!/usr/bin/env python3
+"""
+Floating-point helpers shared by the analysis and validation modules.
+
+Scores and shares are floats, so comparisons that should hold exactly in
+arithmetic can be off in the last bits. Compare them with float_equal, using
+FLOAT_EPSILON for values computed by the package and ROUNDING_TOLERANCE for
+values that were rounded to two decimal places for storage.
+
+Usage:
+    from hansard_tales.numeric import ROUNDING_TOLERANCE, float_equal
+
+    float_equal(0.1 + 0.2, 0.3)                           # True
+    float_equal(34.0 + 12.0, 46.04, ROUNDING_TOLERANCE)   # True
+"""
+
+
+# Default tolerance for comparing floats computed by the package
+FLOAT_EPSILON = 1e-9
+
+# The package's tolerance for values stored rounded to two decimal places:
+# enough to absorb the rounding of each of a few summed values
+ROUNDING_TOLERANCE = 0.05
+
+
+def float_equal(a: float, b: float, epsilon: float = FLOAT_EPSILON) -> bool:
+    """
+    Check whether two floats are equal within a tolerance.
+
+    Args:
+        a: First value
+        b: Second value
+        epsilon: Largest difference still counted as equal
+
+    Returns:
+        True if a and b differ by at most epsilon; False if either is NaN
+    """
+    return abs(a - b) <= epsilon
@@ -0,0 +1,129 @@
+#!/usr/bin/env python3
+"""
+Batch orchestration for reprocessing Hansard sessions.
+
+Reprocessing a whole parliamentary term means running the same work over
+hundreds of sessions. This module runs a function over each session with a
+bounded number of worker threads, stops starting new work as soon as the
+run is cancelled, and collects every failure instead of stopping at the first.
+
+Usage:
+    import threading
+    from hansard_tales.pipeline import process_sessions
+
+    cancel = threading.Event()
+
+    def reprocess(cancel_event, session):
+        if cancel_event.is_set():
+            return
+        updater.process_hansard_pdf(session['pdf_path'], session['url'], session['date'])
+
+    process_sessions(sessions, reprocess, max_workers=4, cancel_event=cancel)
+"""
+import logging
+import threading
+from concurrent.futures import FIRST_COMPLETED, Future, ThreadPoolExecutor, wait
+from typing import Callable, Dict, List, Optional, Set, Tuple
+
+
+# Configure logging
+logging.basicConfig(
+    level=logging.INFO,
+    format='%(asctime)s - %(levelname)s - %(message)s'
+)
+logger = logging.getLogger(__name__)
+
+
+DEFAULT_MAX_WORKERS = 4
+
+
+class BatchProcessingError(Exception):
+    """Raised when a batch run fails for one or more sessions or is cancelled."""
+
+    def __init__(self, errors: List[Tuple[Dict, Exception]], cancelled: bool = False):
+        """
+        Initialize the error.
+
+        Args:
+            errors: List of (session, exception) pairs for failed sessions
+            cancelled: Whether the run was cancelled before all sessions started
+        """
+        self.errors = errors
+        self.cancelled = cancelled
+
+        parts = []
+        if cancelled:
+            parts.append('batch cancelled')
+        if errors:
+            parts.append(f'{len(errors)} session(s) failed')
+            parts.extend(
+                f"{session.get('date', '?')} {session.get('title', '')}: {error}".strip()
+                for session, error in errors
+            )
+
+        super().__init__('; '.join(parts))
+
+
+def process_sessions(
+    sessions: List[Dict],
+    fn: Callable[[threading.Event, Dict], None],
+    max_workers: int = DEFAULT_MAX_WORKERS,
+    cancel_event: Optional[threading.Event] = None
+) -> None:
+    """
+    Run fn over every session with bounded concurrency.
+
+    At most max_workers sessions are processed at once. Once cancel_event is
+    set no further sessions are started; sessions already running receive the
+    same event so they can return early. Failures do not stop the batch and
+    are reported together once all started work has finished.
+
+    Args:
+        sessions: Session dictionaries to process
+        fn: Function called as fn(cancel_event, session)
+        max_workers: Maximum number of sessions processed concurrently
+        cancel_event: Event that cancels the run when set
+
+    Raises:
+        BatchProcessingError: If any session failed or the run was cancelled
+    """
+    if max_workers < 1:
+        raise ValueError(f"max_workers must be at least 1, got {max_workers}")
+
+    if cancel_event is None:
+        cancel_event = threading.Event()
+
+    errors: List[Tuple[Dict, Exception]] = []
+    running: Dict[Future, Dict] = {}
+    cancelled = False
+
+    def collect(done: Set[Future]) -> None:
+        for future in done:
+            session = running.pop(future)
+            error = future.exception()
+            if error is not None:
+                logger.error(f"Failed to process session {session.get('date')}: {error}")
+                errors.append((session, error))
+
+    with ThreadPoolExecutor(max_workers=max_workers) as executor:
+        for session in sessions:
+            if len(running) >= max_workers:
+                done, _ = wait(running, return_when=FIRST_COMPLETED)
+                collect(done)
+
+            if cancel_event.is_set():
+                cancelled = True
+                break
+
+            running[executor.submit(fn, cancel_event, session)] = session
+
+        done, _ = wait(running)
+        collect(done)
+
+    if cancelled:
+        logger.warning("Batch processing cancelled")
+
+    if errors or cancelled:
+        raise BatchProcessingError(errors, cancelled=cancelled)
+
+    logger.info(f"✓ Processed {len(sessions)} sessions")
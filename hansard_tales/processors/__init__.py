@@ -2,6 +2,18 @@
 
 from .pdf_processor import PDFProcessor
 from .mp_identifier import MPIdentifier
+from .mp_matcher import MPMatcher
 from .bill_extractor import BillExtractor
+from .constituency_normalizer import ConstituencyNormalizer
+from .sitting_parser import SittingParser
+from .section_parser import SectionParser
 
-__all__ = ['PDFProcessor', 'MPIdentifier', 'BillExtractor']
+__all__ = [
+    'PDFProcessor',
+    'MPIdentifier',
+    'MPMatcher',
+    'BillExtractor',
+    'ConstituencyNormalizer',
+    'SittingParser',
+    'SectionParser',
+]
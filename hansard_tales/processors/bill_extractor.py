@@ -10,6 +10,8 @@ Usage:
     
     extractor = BillExtractor()
     bills = extractor.extract_bill_references(statement_text)
+    money_bills = [b for b in bills if b.money_bill]
+    assented = {b.bill_type: b.assented_at for b in bills if b.assented_at}
 """
 
 import logging
@@ -17,6 +19,8 @@ import re
 from typing import List, Dict, Optional, Set
 from dataclasses import dataclass
 
+from hansard_tales.dates import InvalidDateError, extract_assent_date
+
 
 # Configure logging
 logging.basicConfig(
@@ -26,6 +30,45 @@ logging.basicConfig(
 logger = logging.getLogger(__name__)
 
 
+# "certified that the Bill is a Money Bill", "a Money Bill within the
+# meaning of Article 114 of the Constitution"
+MONEY_BILL_PATTERN = re.compile(
+    r'\bcertif\w*\b.{0,100}?\bMoney\s+Bill\b'
+    r'|\b(?:is|as|being)\s+a\s+Money\s+Bill\b'
+    r'|\bMoney\s+Bill\s+(?:within\s+the\s+meaning\s+of|under)\s+Article\s+114\b',
+    re.IGNORECASE | re.DOTALL
+)
+
+# "is not a Money Bill", "does not qualify as a Money Bill"
+NOT_MONEY_BILL_PATTERN = re.compile(r'\bnot\b(?:\s+\w+){0,3}?\s+a\s+Money\s+Bill\b', re.IGNORECASE)
+
+# Sentence boundaries used to keep a negation with the claim it negates
+SENTENCE_SPLIT_PATTERN = re.compile(r'(?<=[.;])\s+')
+
+
+def is_money_bill(text: str) -> bool:
+    """
+    Check whether a bill's text certifies it as a Money Bill.
+
+    The Speaker's certification, or a statement that the Bill is a Money
+    Bill under Article 114, counts; a sentence saying the Bill is not a
+    Money Bill does not.
+
+    Args:
+        text: Bill text or the Speaker's communication on it
+
+    Returns:
+        True if some sentence certifies the bill as a Money Bill
+    """
+    if not text:
+        return False
+
+    return any(
+        MONEY_BILL_PATTERN.search(sentence) and not NOT_MONEY_BILL_PATTERN.search(sentence)
+        for sentence in SENTENCE_SPLIT_PATTERN.split(text)
+    )
+
+
 @dataclass
 class BillReference:
     """Represents a bill reference found in text."""
@@ -34,6 +77,8 @@ class BillReference:
     full_text: str = ""
     position: int = 0
     bill_type: Optional[str] = None  # e.g., "Finance", "Appropriation"
+    money_bill: bool = False  # Certified as a Money Bill (Article 114)
+    assented_at: Optional[str] = None  # YYYY-MM-DD, once assented to
 
 
 class BillExtractor:
@@ -97,6 +142,11 @@ class BillExtractor:
         """
         Extract all bill references from text.
         
+        A reference is flagged as a Money Bill when the sentence it appears
+        in certifies it as one (see is_money_bill), and given an assent
+        date when that sentence says it was "assented to on" a date. For a
+        bill mentioned several times, any such mention counts.
+        
         Args:
             text: Text to search for bill references
             
@@ -207,25 +257,64 @@ class BillExtractor:
             # Couldn't extract enough info
             return None
         
+        sentence = self._sentence_around(text, match)
+        
+        try:
+            assented_at = extract_assent_date(sentence)
+        except InvalidDateError as e:
+            logger.warning(f"Ignoring assent date for {full_text}: {e}")
+            assented_at = None
+        
         return BillReference(
             bill_number=bill_number or "",
             bill_year=bill_year,
             full_text=full_text,
             position=position,
-            bill_type=bill_type
+            bill_type=bill_type,
+            money_bill=is_money_bill(sentence),
+            assented_at=assented_at
         )
     
+    def _sentence_around(self, text: str, match: re.Match) -> str:
+        """
+        Get the sentence a bill reference appears in.
+        
+        Sentence boundaries inside the reference itself (the full stop in
+        "Bill No. 123") are ignored.
+        
+        Args:
+            text: Full text
+            match: Regex match for the reference
+            
+        Returns:
+            Text of the surrounding sentence
+        """
+        start = 0
+        end = len(text)
+        
+        for boundary in SENTENCE_SPLIT_PATTERN.finditer(text):
+            if boundary.end() <= match.start():
+                start = boundary.end()
+            elif boundary.start() >= match.end():
+                end = boundary.start()
+                break
+        
+        return text[start:end]
+    
     def _deduplicate_bills(self, bills: List[BillReference]) -> List[BillReference]:
         """
         Remove duplicate bill references.
         
+        The first mention of each bill is kept, flagged as a Money Bill if
+        any of its mentions is and given the first assent date mentioned.
+        
         Args:
             bills: List of BillReference objects
             
         Returns:
             Deduplicated list
         """
-        seen = set()
+        seen = {}
         unique = []
         
         for bill in bills:
@@ -240,8 +329,12 @@ class BillExtractor:
                 key = bill.full_text
             
             if key not in seen:
-                seen.add(key)
+                seen[key] = bill
                 unique.append(bill)
+            else:
+                kept = seen[key]
+                kept.money_bill = kept.money_bill or bill.money_bill
+                kept.assented_at = kept.assented_at or bill.assented_at
         
         return unique
     
@@ -411,6 +504,8 @@ def main():
                     'bill_type': bill.bill_type,
                     'full_text': bill.full_text,
                     'position': bill.position,
+                    'money_bill': bill.money_bill,
+                    'assented_at': bill.assented_at,
                     'formatted': extractor.format_bill_reference(bill)
                 }
                 for bill in all_bills
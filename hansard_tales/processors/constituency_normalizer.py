@@ -0,0 +1,251 @@
+#!/usr/bin/env python3
+"""
+Constituency name normalization for matching across data sources.
+
+The parliament website, Hansard speaker labels and third-party datasets all
+format constituency names differently ("EMBAKASI CENTRAL", "Mombasa (Mvita)",
+"Mvita Constituency"). This module reduces them to one canonical form so
+records from different sources can be joined.
+
+Usage:
+    from hansard_tales.processors.constituency_normalizer import ConstituencyNormalizer
+
+    normalizer = ConstituencyNormalizer()
+    normalizer.canonicalize("Mombasa (Mvita)")  # "Mvita"
+    normalizer.related_constituencies("Kuria East", constituencies)  # ["Kuria West"]
+"""
+
+import re
+import unicodedata
+from typing import Iterable, List, Optional, Tuple
+
+
+# The 47 counties established by the Constitution of Kenya (2010)
+KENYA_COUNTIES = {
+    'Baringo', 'Bomet', 'Bungoma', 'Busia', 'Elgeyo Marakwet', 'Embu',
+    'Garissa', 'Homa Bay', 'Isiolo', 'Kajiado', 'Kakamega', 'Kericho',
+    'Kiambu', 'Kilifi', 'Kirinyaga', 'Kisii', 'Kisumu', 'Kitui', 'Kwale',
+    'Laikipia', 'Lamu', 'Machakos', 'Makueni', 'Mandera', 'Marsabit',
+    'Meru', 'Migori', 'Mombasa', "Murang'a", 'Nairobi', 'Nakuru', 'Nandi',
+    'Narok', 'Nyamira', 'Nyandarua', 'Nyeri', 'Samburu', 'Siaya',
+    'Taita Taveta', 'Tana River', 'Tharaka Nithi', 'Trans Nzoia', 'Turkana',
+    'Uasin Gishu', 'Vihiga', 'Wajir', 'West Pokot',
+}
+
+# Compass qualifiers distinguishing sibling constituencies ("Kuria East",
+# "South Imenti"), with the abbreviations seen in source data
+DIRECTIONS = {
+    'north': 'North', 'n': 'North', 'nth': 'North',
+    'south': 'South', 's': 'South', 'sth': 'South',
+    'east': 'East', 'e': 'East',
+    'west': 'West', 'w': 'West',
+    'central': 'Central', 'c': 'Central', 'ctrl': 'Central',
+}
+
+
+class ConstituencyNormalizer:
+    """Normalizes constituency names to a canonical display form."""
+
+    # "Mvita Constituency" -> "Mvita"
+    CONSTITUENCY_SUFFIX = re.compile(r'\s+constituency$', re.IGNORECASE)
+
+    # "Mombasa (Mvita)" or "Mvita (Mombasa County)"
+    PARENTHETICAL = re.compile(r'^(?P<outer>[^()]*?)\s*\((?P<inner>[^()]*)\)\s*$')
+
+    COUNTY_SUFFIX = re.compile(r'\s+county$', re.IGNORECASE)
+
+    # "Kuria-East" or "Igembe/North" written with a separator
+    DIRECTION_SEPARATOR = re.compile(
+        r'\s*[-/]\s*(?=(?:north|south|east|west|central)$)', re.IGNORECASE
+    )
+
+    def __init__(self):
+        """Initialize the constituency normalizer."""
+        self._counties_by_key = {self._key(county): county for county in KENYA_COUNTIES}
+        self._county_keys = set(self._counties_by_key)
+
+    @staticmethod
+    def _key(name: str) -> str:
+        """Build a comparison key that ignores case and punctuation variants."""
+        name = unicodedata.normalize('NFKD', name)
+        name = ''.join(char for char in name if not unicodedata.combining(char))
+        return re.sub(r"[^a-z0-9]", '', name.lower())
+
+    @staticmethod
+    def _title_word(word: str) -> str:
+        """Title-case a single word, leaving letters after apostrophes lowercase."""
+        parts = []
+        for part in word.lower().split('-'):
+            # Skip leading punctuation such as "(" when finding the first letter
+            match = re.search(r'[^\W\d_]', part)
+            if match:
+                i = match.start()
+                part = part[:i] + part[i].upper() + part[i + 1:]
+            parts.append(part)
+        return '-'.join(parts)
+
+    def normalize(self, name: Optional[str]) -> str:
+        """
+        Normalize whitespace, casing and the trailing "Constituency" label.
+
+        Args:
+            name: Raw constituency name
+
+        Returns:
+            Normalized name, or an empty string for missing input
+        """
+        if not name:
+            return ''
+
+        name = ' '.join(name.replace('’', "'").split())
+        name = self.CONSTITUENCY_SUFFIX.sub('', name)
+
+        return ' '.join(self._title_word(word) for word in name.split())
+
+    def is_county(self, name: str) -> bool:
+        """
+        Check whether a name refers to one of the 47 counties.
+
+        Args:
+            name: Name to check (an optional trailing "County" is ignored)
+
+        Returns:
+            True if the name is a county
+        """
+        name = self.COUNTY_SUFFIX.sub('', name.strip())
+        return self._key(name) in self._county_keys
+
+    def canonical_county(self, name: Optional[str]) -> Optional[str]:
+        """
+        Map a county name to its spelling in KENYA_COUNTIES.
+
+        Args:
+            name: County name in any casing, e.g. "MURANGÁ" or "Nairobi County"
+
+        Returns:
+            Canonical county name, or None if the name is not a county
+        """
+        if not name:
+            return None
+
+        name = self.COUNTY_SUFFIX.sub('', name.strip())
+        return self._counties_by_key.get(self._key(name))
+
+    def county_of(self, name: Optional[str]) -> Optional[str]:
+        """
+        Find the county a constituency name places itself in.
+
+        The county is read from a county qualifier, as in "Mombasa (Mvita)"
+        or "Mvita (Mombasa County)", or from a name that is itself a county,
+        as for a Woman Representative's seat.
+
+        Args:
+            name: Raw constituency name
+
+        Returns:
+            Canonical county name, or None if the name does not give one
+        """
+        name = self.normalize(name)
+
+        match = self.PARENTHETICAL.match(name)
+        if match:
+            return (
+                self.canonical_county(match.group('outer'))
+                or self.canonical_county(match.group('inner'))
+            )
+
+        return self.canonical_county(name)
+
+    def canonicalize(self, name: Optional[str]) -> str:
+        """
+        Reduce a constituency name to its canonical form.
+
+        In addition to normalize(), a parenthetical county qualifier is
+        removed so that "Mombasa (Mvita)", "Mvita (Mombasa County)" and
+        "MVITA" all canonicalize to "Mvita". A trailing compass qualifier is
+        spelled out in full, so "Kuria E.", "Kuria-East" and "KURIA EAST"
+        all canonicalize to "Kuria East".
+
+        Args:
+            name: Raw constituency name
+
+        Returns:
+            Canonical constituency name
+        """
+        name = self.normalize(name)
+
+        match = self.PARENTHETICAL.match(name)
+        if match:
+            outer = match.group('outer').strip()
+            inner = match.group('inner').strip()
+
+            if outer and self.is_county(outer) and inner:
+                # "Mombasa (Mvita)" - county outside, constituency inside
+                name = inner
+            elif inner and self.is_county(inner) and outer:
+                # "Mvita (Mombasa County)" - constituency outside
+                name = outer
+
+        name = self.DIRECTION_SEPARATOR.sub(' ', self.normalize(name))
+
+        words = name.split()
+        if len(words) > 1:
+            direction = DIRECTIONS.get(words[-1].rstrip('.').lower())
+            if direction:
+                words[-1] = direction
+
+        return self.normalize(' '.join(words))
+
+    def split_direction(self, name: Optional[str]) -> Tuple[str, str]:
+        """
+        Separate a constituency's base name from its compass qualifier.
+
+        The qualifier may follow the base name ("Kuria East") or precede it
+        ("South Imenti").
+
+        Args:
+            name: Raw constituency name
+
+        Returns:
+            Tuple of (base name, direction); the direction is empty for
+            names without one
+        """
+        words = self.canonicalize(name).split()
+        if len(words) > 1:
+            if words[-1] in DIRECTIONS.values():
+                return ' '.join(words[:-1]), words[-1]
+            if words[0] in DIRECTIONS.values():
+                return ' '.join(words[1:]), words[0]
+
+        return ' '.join(words), ''
+
+    def related_constituencies(self, name: Optional[str], constituencies: Iterable[str]) -> List[str]:
+        """
+        Find sibling constituencies that share a base name.
+
+        "Igembe North" is related to "Igembe Central" and "Igembe South", and
+        a bare base name such as "Kuria" is related to every directional
+        constituency built on it.
+
+        Args:
+            name: Constituency to find siblings of
+            constituencies: Known constituency names to search, e.g. from
+                MP records
+
+        Returns:
+            Sorted canonical names of the siblings, excluding name itself
+        """
+        base, _ = self.split_direction(name)
+        if not base:
+            return []
+
+        own = self.canonicalize(name)
+        related = set()
+
+        for other in constituencies:
+            other_base, other_direction = self.split_direction(other)
+            canonical = self.canonicalize(other)
+            if other_direction and other_base == base and canonical != own:
+                related.add(canonical)
+
+        return sorted(related)
@@ -13,10 +13,14 @@ Usage:
 """
 
 import logging
+import os
 import re
+from concurrent.futures import ThreadPoolExecutor
 from typing import Dict, List, Optional, Tuple
 from dataclasses import dataclass
 
+from hansard_tales.processors.constituency_normalizer import ConstituencyNormalizer
+
 
 # Configure logging
 logging.basicConfig(
@@ -26,15 +30,39 @@ logging.basicConfig(
 logger = logging.getLogger(__name__)
 
 
+# Statement kinds set by MPIdentifier.classify_statement
+STATEMENT_SUBSTANTIVE = 'substantive'
+STATEMENT_POINT_OF_ORDER = 'point_of_order'
+STATEMENT_PROCEDURAL = 'procedural'
+
+# A line consisting only of upper-case words, e.g. "NOTICES OF MOTION",
+# which starts a section; shared with SectionParser
+HEADING_PATTERN = re.compile(r"^[ \t]*([A-Z][A-Z'’,&()\- ]*[A-Z)])[ \t]*$", re.MULTILINE)
+
+
 @dataclass
 class Statement:
-    """Represents a statement made by an MP in Hansard."""
+    """Represents a statement made by an MP in Hansard.
+    
+    start_position/end_position span the whole contribution including the
+    speaker label, while text_start/text_end span exactly the statement text,
+    so that source[text_start:text_end] == text. All offsets index into the
+    string that was passed to MPIdentifier (the page text for statements
+    extracted page by page).
+    
+    kind is one of STATEMENT_SUBSTANTIVE, STATEMENT_POINT_OF_ORDER or
+    STATEMENT_PROCEDURAL, so metrics can leave out contributions that are
+    not debate.
+    """
     mp_name: str
     text: str
     start_position: int
     end_position: int
     page_number: Optional[int] = None
     confidence: float = 1.0
+    text_start: Optional[int] = None
+    text_end: Optional[int] = None
+    kind: str = STATEMENT_SUBSTANTIVE
 
 
 class MPIdentifier:
@@ -66,6 +94,48 @@ class MPIdentifier:
         'The Temporary Chairperson',
     }
     
+    # Name particles that stay lowercase inside a name ("Katoo ole Metito")
+    NAME_PARTICLES = {'ole', 'wa', 'bin', 'binti', 'arap'}
+    
+    # Titles that can precede a name but are not part of it
+    HONORIFICS = {'hon', 'dr', 'prof', 'mr', 'mrs', 'ms', 'eng', 'amb', 'sen', 'rtd'}
+    
+    # "Hon. John Mbadi (Suba South, ODM)" or "Hon. (Dr.) Jane Doe (Nominated, UDA):"
+    SPEAKER_INTRO_PATTERN = re.compile(
+        r'^\s*(?:Hon\.\s*)?(?P<name>(?:\([^)]*\)\s*)*[^(),:]+?)\s*'
+        r'\(\s*(?P<constituency>[^(),]+?)\s*,\s*(?P<party>[^(),]+?)\s*\)\s*:?\s*$'
+    )
+    
+    # A Member rising on a point of order: "On a point of order, Hon.
+    # Speaker." or "Hon. Speaker, I rise on a point of order", but not a
+    # ruling such as "That is not a point of order."
+    POINT_OF_ORDER_PATTERN = re.compile(
+        r'^\W*(?:(?:Hon\.?\s+|Mr\.?\s+|Madam\s+)?(?:Temporary\s+)?(?:Deputy\s+)?(?:Speaker|Chairperson)\s*,\s*)?'
+        r'(?:I\s+(?:rise|stand|am\s+rising)\s+)?on\s+a\s+point\s+of\s+order\b',
+        re.IGNORECASE
+    )
+    
+    # Formal business with no debate: "I beg to move.", "I second.",
+    # "Hon. Speaker, I beg to lay the following Papers on the Table:"
+    PROCEDURAL_PATTERN = re.compile(
+        r'^\W*(?:(?:Hon\.?\s+|Mr\.?\s+|Madam\s+)?(?:Temporary\s+)?(?:Deputy\s+)?(?:Speaker|Chairperson)\s*,\s*)?'
+        r'I\s+(?:beg\s+to\s+|rise\s+to\s+)?(?:move|second|reply|lay|give\s+notice)\b',
+        re.IGNORECASE
+    )
+    
+    # Contributions longer than this are debate even if they open formally
+    PROCEDURAL_MAX_WORDS = 15
+    
+    # Kinds of every contribution in these sections, until the next heading
+    SECTION_KINDS = {
+        'POINTS OF ORDER': STATEMENT_POINT_OF_ORDER,
+        'POINT OF ORDER': STATEMENT_POINT_OF_ORDER,
+        'PAPERS LAID': STATEMENT_PROCEDURAL,
+        'PAPERS': STATEMENT_PROCEDURAL,
+        'NOTICES OF MOTION': STATEMENT_PROCEDURAL,
+        'NOTICE OF MOTION': STATEMENT_PROCEDURAL,
+    }
+    
     def __init__(self, use_spacy: bool = False):
         """
         Initialize the MP identifier.
@@ -85,12 +155,15 @@ class MPIdentifier:
                 logger.warning(f"Could not load spaCy model: {e}")
                 self.use_spacy = False
     
-    def normalize_mp_name(self, name: str) -> str:
+    def normalize_mp_name(self, name: str, keep_initials: bool = True) -> str:
         """
         Normalize MP name for consistent database storage.
         
         Args:
             name: Raw MP name from text
+            keep_initials: Whether to keep single-letter initials ("K." or "K").
+                Keeping them (the default) avoids merging "John K. Mbadi"
+                with a different "John Mbadi".
             
         Returns:
             Normalized name
@@ -102,11 +175,169 @@ class MPIdentifier:
         name = re.sub(r'\s*\([^)]*\)\s*', '', name)  # Remove parenthetical content
         name = re.sub(r'\s*,\s*MP\s*$', '', name, flags=re.IGNORECASE)  # Remove ", MP"
         
+        if not keep_initials:
+            name = ' '.join(
+                word for word in name.split()
+                if not re.fullmatch(r'[^\W\d_]\.?', word)
+            )
+        
         # Title case
         name = name.title()
         
         return name.strip()
     
+    def normalize_mp_names(
+        self,
+        names: List[str],
+        workers: int = 0,
+        keep_initials: bool = True
+    ) -> List[str]:
+        """
+        Normalize a batch of MP names concurrently.
+        
+        Args:
+            names: Raw MP names
+            workers: Maximum number of names normalized at once; 0 or less
+                uses one worker per CPU. Set it to bound CPU use when the
+                normalizer shares a machine with other work.
+            keep_initials: Passed through to normalize_mp_name
+            
+        Returns:
+            Normalized names in the same order as the input
+        """
+        if workers <= 0:
+            workers = os.cpu_count() or 1
+        
+        with ThreadPoolExecutor(max_workers=workers) as executor:
+            return list(executor.map(
+                lambda name: self.normalize_mp_name(name, keep_initials=keep_initials),
+                names
+            ))
+    
+    def has_name(self, name: str) -> bool:
+        """
+        Check whether a speaker name still contains a name once normalized.
+        
+        Labels such as "Hon. " or "(Dr) " normalize to nothing but a title
+        and would otherwise create blank-named speakers.
+        
+        Args:
+            name: Raw MP name from text
+            
+        Returns:
+            True if the normalized name has a word that is not a title
+        """
+        words = self.normalize_mp_name(name or '').split()
+        return any(word.rstrip('.').lower() not in self.HONORIFICS for word in words)
+    
+    def parse_speaker_intro(self, line: str) -> Optional[Tuple[str, str, str]]:
+        """
+        Parse the name, constituency and party from a speaker introduction.
+        
+        Members are introduced as "Hon. John Mbadi (Suba South, ODM)", which
+        makes the introduction a reliable source for filling MP records.
+        
+        Args:
+            line: Introduction line
+            
+        Returns:
+            Tuple of (normalized name, canonical constituency, party), or
+            None if the line is not an introduction
+        """
+        match = self.SPEAKER_INTRO_PATTERN.match(line or '')
+        if not match or not self.has_name(match.group('name')):
+            return None
+        
+        name = self.normalize_mp_name(match.group('name'))
+        constituency = ConstituencyNormalizer().canonicalize(match.group('constituency'))
+        
+        # Acronyms such as "odm" or "Ford-k" are upper-cased; names such as
+        # "Jubilee" or "Wiper" keep their casing
+        party = ' '.join(match.group('party').split())
+        if len(party) <= 4 or '-' in party:
+            party = party.upper()
+        
+        return name, constituency, party
+    
+    def title_case_name(self, name: str) -> str:
+        """
+        Title-case an MP name for display.
+        
+        Unlike str.title(), letters after an apostrophe stay lowercase
+        ("NG'ONG'O" -> "Ng'ong'o") and name particles such as "ole", "wa"
+        and "bin" are lowercased unless they start the name.
+        
+        Args:
+            name: Name in any casing (typically from normalize_mp_name)
+            
+        Returns:
+            Display-cased name
+        """
+        words = []
+        
+        for i, word in enumerate(name.split()):
+            word = word.lower()
+            
+            if i > 0 and word in self.NAME_PARTICLES:
+                words.append(word)
+                continue
+            
+            # Capitalize the first letter and any letter after "-", "." or "("
+            word = re.sub(r"(^|[-.(])([a-z])", lambda m: m.group(1) + m.group(2).upper(), word)
+            words.append(word)
+        
+        return ' '.join(words)
+    
+    def split_name(self, name: str) -> Tuple[str, str]:
+        """
+        Split an MP name into given names and surname for sorting.
+        
+        Names are assumed to follow the "Given Middle Surname" order, with
+        the last word as the surname. Name particles immediately before the
+        surname stay with it ("Katoo ole Metito" -> "ole Metito"), even when
+        there is no given name ("wa Kabando"). Apostrophes are part of a word
+        ("Ng'ang'a"). Names in the parliament website's "SURNAME, GIVEN" form
+        are split at the comma.
+        
+        Args:
+            name: MP name
+            
+        Returns:
+            Tuple of (given names, surname); given names may be empty
+        """
+        # Drop parenthetical titles such as "(DR.)"
+        name = re.sub(r'\([^)]*\)', ' ', name)
+        
+        if ',' in name:
+            surname, given = name.split(',', 1)
+            return ' '.join(given.split()), ' '.join(surname.split())
+        
+        words = name.split()
+        if not words:
+            return '', ''
+        
+        surname_start = len(words) - 1
+        while surname_start > 0 and words[surname_start - 1].lower() in self.NAME_PARTICLES:
+            surname_start -= 1
+        
+        return ' '.join(words[:surname_start]), ' '.join(words[surname_start:])
+    
+    def sort_mps_by_surname(self, mps: List[Dict]) -> List[Dict]:
+        """
+        Sort MP records by surname, then given names.
+        
+        Args:
+            mps: List of MP dictionaries with a 'name' key
+            
+        Returns:
+            New list of MP dictionaries in surname order
+        """
+        def sort_key(mp: Dict) -> Tuple[str, str]:
+            given, surname = self.split_name(mp.get('name') or '')
+            return surname.lower(), given.lower()
+        
+        return sorted(mps, key=sort_key)
+    
     def validate_name_with_spacy(self, name: str) -> bool:
         """
         Validate that a name looks like a person name using spaCy NER.
@@ -196,6 +427,79 @@ class MPIdentifier:
         
         return statement
     
+    def statement_text_span(
+        self,
+        text: str,
+        start_pos: int,
+        next_speaker_pos: Optional[int] = None
+    ) -> Tuple[int, int]:
+        """
+        Find the span of statement text between a speaker label and the next.
+        
+        The span matches what extract_statement_text returns: surrounding
+        whitespace and a leading colon are excluded.
+        
+        Args:
+            text: Full Hansard text
+            start_pos: Start position (after speaker name)
+            next_speaker_pos: Position of next speaker (or None for end of text)
+            
+        Returns:
+            Tuple of (start, end) offsets into text
+        """
+        start = start_pos
+        end = len(text) if next_speaker_pos is None else next_speaker_pos
+        
+        while start < end and text[start].isspace():
+            start += 1
+        
+        if start < end and text[start] == ':':
+            start += 1
+            while start < end and text[start].isspace():
+                start += 1
+        
+        while end > start and text[end - 1].isspace():
+            end -= 1
+        
+        return start, end
+    
+    def classify_statement(self, text: str, section: Optional[str] = None) -> str:
+        """
+        Classify a contribution as substantive debate, a point of order or
+        procedural business.
+        
+        Args:
+            text: Statement text
+            section: Optional heading of the section the statement is in;
+                sections in SECTION_KINDS decide the kind
+            
+        Returns:
+            STATEMENT_POINT_OF_ORDER if the Member rises on a point of order,
+            STATEMENT_PROCEDURAL for a short formal contribution such as
+            "I beg to move.", otherwise STATEMENT_SUBSTANTIVE
+        """
+        if section:
+            kind = self.SECTION_KINDS.get(' '.join(section.upper().split()))
+            if kind:
+                return kind
+        
+        if self.POINT_OF_ORDER_PATTERN.match(text):
+            return STATEMENT_POINT_OF_ORDER
+        
+        if self.PROCEDURAL_PATTERN.match(text) and len(text.split()) <= self.PROCEDURAL_MAX_WORDS:
+            return STATEMENT_PROCEDURAL
+        
+        return STATEMENT_SUBSTANTIVE
+    
+    def _section_at(self, headings: List[Tuple[int, str]], position: int) -> Optional[str]:
+        """Find the heading of the section containing a position."""
+        section = None
+        for start, name in headings:
+            if start > position:
+                break
+            section = name
+        return section
+    
     def extract_statements(
         self,
         text: str,
@@ -205,6 +509,9 @@ class MPIdentifier:
         """
         Extract all MP statements from Hansard text.
         
+        Each statement's kind is set by classify_statement, using the
+        upper-case heading above it as its section.
+        
         Args:
             text: Hansard text to process
             page_number: Optional page number for attribution
@@ -220,11 +527,19 @@ class MPIdentifier:
             return []
         
         statements = []
+        headings = [
+            (match.start(), ' '.join(match.group(1).split()))
+            for match in HEADING_PATTERN.finditer(text)
+        ]
         
         for i, (speaker_name, start_pos, end_pos) in enumerate(speakers):
             # Normalize the name
             normalized_name = self.normalize_mp_name(speaker_name)
             
+            if not self.has_name(speaker_name):
+                logger.debug(f"Skipping speaker label without a name: {speaker_name!r}")
+                continue
+            
             # Filter out non-MP speakers if requested
             if filter_non_mps and normalized_name in self.NON_MP_SPEAKERS:
                 logger.debug(f"Skipping non-MP speaker: {normalized_name}")
@@ -239,7 +554,8 @@ class MPIdentifier:
             next_start_pos = speakers[i + 1][1] if i + 1 < len(speakers) else None
             
             # Extract statement text (start after the speaker pattern)
-            statement_text = self.extract_statement_text(text, end_pos, next_start_pos)
+            text_start, text_end = self.statement_text_span(text, end_pos, next_start_pos)
+            statement_text = text[text_start:text_end]
             
             # Skip empty statements
             if not statement_text or len(statement_text) < 10:
@@ -253,7 +569,10 @@ class MPIdentifier:
                 start_position=start_pos,
                 end_position=next_start_pos or len(text),
                 page_number=page_number,
-                confidence=1.0
+                confidence=1.0,
+                text_start=text_start,
+                text_end=text_end,
+                kind=self.classify_statement(statement_text, self._section_at(headings, start_pos))
             )
             
             statements.append(statement)
@@ -367,6 +686,8 @@ def main():
     import json
     from pathlib import Path
     
+    from hansard_tales.statements import split_short_statements
+    
     parser = argparse.ArgumentParser(
         description="Extract MP statements from Hansard text"
     )
@@ -384,6 +705,12 @@ def main():
         action="store_true",
         help="Include non-MP speakers (Speaker, Chairperson, etc.)"
     )
+    parser.add_argument(
+        "--min-words",
+        type=int,
+        default=0,
+        help="Drop statements with fewer words than this (default: keep all)"
+    )
     parser.add_argument(
         "--output",
         help="Output JSON file for statements"
@@ -425,6 +752,8 @@ def main():
             filter_non_mps=not args.include_non_mps
         )
     
+    statements, _ = split_short_statements(statements, args.min_words)
+    
     # Print statistics
     stats = identifier.get_statistics(statements)
     
@@ -456,6 +785,8 @@ def main():
                     'page_number': stmt.page_number,
                     'start_position': stmt.start_position,
                     'end_position': stmt.end_position,
+                    'text_start': stmt.text_start,
+                    'text_end': stmt.text_end,
                     'confidence': stmt.confidence
                 }
                 for stmt in statements
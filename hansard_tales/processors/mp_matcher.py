@@ -0,0 +1,299 @@
+#!/usr/bin/env python3
+"""
+Fuzzy matching of Hansard speakers to known MPs.
+
+Speaker labels in Hansard are frequently misspelled or mangled by OCR, so
+statements cannot always be attributed by exact name lookup. This module
+scores candidate MPs by edit distance and reports how confident the match is.
+Names mangled beyond what edit distance tolerates can optionally fall back to
+a phonetic key comparison.
+
+Usage:
+    from hansard_tales.processors.mp_matcher import MPMatcher
+
+    matcher = MPMatcher()
+    mp, confidence = matcher.match_statement_to_mp_scored(statement, mps)
+    if confidence >= MPMatcher.DEFAULT_CONFIDENCE_THRESHOLD:
+        ...
+"""
+
+import logging
+import re
+from dataclasses import replace
+from typing import Dict, List, Optional, Tuple
+
+from hansard_tales.processors.mp_identifier import MPIdentifier, Statement
+
+
+# Configure logging
+logging.basicConfig(
+    level=logging.INFO,
+    format='%(asctime)s - %(levelname)s - %(message)s'
+)
+logger = logging.getLogger(__name__)
+
+
+class MPMatcher:
+    """Matches speaker names from Hansard text to MP records."""
+
+    # Suggested cut-off below which callers should leave a statement unattributed
+    DEFAULT_CONFIDENCE_THRESHOLD = 0.8
+
+    # Confidence penalty applied when several MPs tie and nothing disambiguates them
+    AMBIGUITY_PENALTY = 0.5
+
+    # Confidence given to a match made on phonetic key alone
+    PHONETIC_MATCH_CONFIDENCE = DEFAULT_CONFIDENCE_THRESHOLD
+
+    # Soundex consonant codes. "l" and "r" share a code because they are
+    # interchangeable in the spelling of many Kenyan names.
+    PHONETIC_CODES = {
+        **dict.fromkeys('bfpv', '1'),
+        **dict.fromkeys('cgjkqsxz', '2'),
+        **dict.fromkeys('dt', '3'),
+        **dict.fromkeys('lr', '4'),
+        **dict.fromkeys('mn', '5'),
+    }
+
+    def __init__(self):
+        """Initialize the MP matcher."""
+        self.identifier = MPIdentifier()
+
+    @staticmethod
+    def levenshtein_distance(a: str, b: str) -> int:
+        """
+        Compute the Levenshtein edit distance between two strings.
+
+        Args:
+            a: First string
+            b: Second string
+
+        Returns:
+            Minimum number of single-character edits turning a into b
+        """
+        if len(a) < len(b):
+            a, b = b, a
+
+        previous = list(range(len(b) + 1))
+        for i, char_a in enumerate(a, start=1):
+            current = [i]
+            for j, char_b in enumerate(b, start=1):
+                current.append(min(
+                    previous[j] + 1,                       # deletion
+                    current[j - 1] + 1,                    # insertion
+                    previous[j - 1] + (char_a != char_b)   # substitution
+                ))
+            previous = current
+
+        return previous[-1]
+
+    def _comparable(self, name: str) -> str:
+        """Normalize a name into the form used for distance comparison."""
+        return self.identifier.normalize_mp_name(name).lower()
+
+    def name_similarity(self, a: str, b: str) -> float:
+        """
+        Score how close two names are on a 0-1 scale.
+
+        Args:
+            a: First name
+            b: Second name
+
+        Returns:
+            1.0 for identical names, falling towards 0.0 as edit distance grows
+        """
+        a = self._comparable(a)
+        b = self._comparable(b)
+
+        longest = max(len(a), len(b))
+        if longest == 0:
+            return 0.0
+
+        return 1.0 - self.levenshtein_distance(a, b) / longest
+
+    def phonetic_key(self, name: str) -> str:
+        """
+        Compute a Soundex-style key for a name.
+
+        Each word is coded separately as its first letter followed by up to
+        three consonant codes, so "Opiyo Wandayi" and "Opio Wandai" share
+        the key "O100 W530". Unlike standard Soundex, apostrophes are
+        dropped ("Ng'ang'a" sounds like "Nganga") and "l" and "r" are
+        treated alike.
+
+        Args:
+            name: Name to encode
+
+        Returns:
+            Space-separated keys, one per word (empty for an empty name)
+        """
+        keys = []
+
+        for word in self._comparable(name).split():
+            letters = re.sub(r'[^a-z]', '', word)
+            if not letters:
+                continue
+
+            key = letters[0].upper()
+            previous = self.PHONETIC_CODES.get(letters[0])
+
+            for letter in letters[1:]:
+                code = self.PHONETIC_CODES.get(letter)
+                if code and code != previous:
+                    key += code
+                # "h" and "w" do not separate repeated codes; vowels do
+                if letter not in 'hw':
+                    previous = code
+
+            keys.append((key + '000')[:4])
+
+        return ' '.join(keys)
+
+    @staticmethod
+    def _role_matches(mp: Dict, role: str) -> bool:
+        """Check whether a role label (constituency or party) fits an MP."""
+        role = role.strip().lower()
+        for field in ('constituency', 'party'):
+            value = mp.get(field)
+            if value and value.strip().lower() == role:
+                return True
+        return False
+
+    def match_name_scored(
+        self,
+        name: str,
+        mps: List[Dict],
+        role: Optional[str] = None,
+        phonetic_fallback: bool = False
+    ) -> Tuple[Optional[Dict], float]:
+        """
+        Find the MP whose name best matches a speaker name.
+
+        When several MPs are equally close, the optional role label (for
+        example the constituency in "Hon. John Mbadi (Suba South)") is used
+        to pick between them. Ties that the role cannot resolve are penalised
+        so that callers can leave them unattributed.
+
+        With phonetic_fallback, a name whose best edit-distance match falls
+        below DEFAULT_CONFIDENCE_THRESHOLD is matched instead to MPs with
+        the same phonetic_key, at PHONETIC_MATCH_CONFIDENCE.
+
+        Args:
+            name: Speaker name as it appears in Hansard
+            mps: List of MP dictionaries with at least a 'name' key
+            role: Optional constituency or party label from the speaker line
+            phonetic_fallback: Whether to try phonetic keys when edit
+                distance gives no confident match
+
+        Returns:
+            Tuple of (best matching MP or None, confidence between 0 and 1)
+        """
+        if not mps or not self.identifier.has_name(name):
+            return None, 0.0
+
+        scored = [(self.name_similarity(name, mp.get('name', '')), mp) for mp in mps]
+        best_score = max(score for score, _ in scored)
+
+        if phonetic_fallback and best_score < self.DEFAULT_CONFIDENCE_THRESHOLD:
+            key = self.phonetic_key(name)
+            sounds_alike = [mp for mp in mps if key and self.phonetic_key(mp.get('name', '')) == key]
+            if sounds_alike:
+                logger.debug(f"Phonetic match for {name}: {len(sounds_alike)} candidates")
+                return self._pick_candidate(name, sounds_alike, self.PHONETIC_MATCH_CONFIDENCE, role)
+
+        if best_score <= 0:
+            return None, 0.0
+
+        candidates = [mp for score, mp in scored if score == best_score]
+        return self._pick_candidate(name, candidates, best_score, role)
+
+    def _pick_candidate(
+        self,
+        name: str,
+        candidates: List[Dict],
+        best_score: float,
+        role: Optional[str]
+    ) -> Tuple[Dict, float]:
+        """Choose between equally good candidates, penalising unresolved ties."""
+        if len(candidates) == 1:
+            return candidates[0], best_score
+
+        if role:
+            by_role = [mp for mp in candidates if self._role_matches(mp, role)]
+            if len(by_role) == 1:
+                logger.debug(f"Role '{role}' disambiguated match for {name}")
+                return by_role[0], best_score
+
+        logger.debug(f"Ambiguous match for {name}: {len(candidates)} candidates")
+        return candidates[0], best_score * self.AMBIGUITY_PENALTY
+
+    def match_statement_to_mp_scored(
+        self,
+        statement: Statement,
+        mps: List[Dict],
+        role: Optional[str] = None,
+        phonetic_fallback: bool = False
+    ) -> Tuple[Optional[Dict], float]:
+        """
+        Match a statement's speaker to an MP with a confidence score.
+
+        Args:
+            statement: Statement extracted by MPIdentifier
+            mps: List of MP dictionaries with at least a 'name' key
+            role: Optional constituency or party label from the speaker line
+            phonetic_fallback: Whether to try phonetic keys when edit
+                distance gives no confident match
+
+        Returns:
+            Tuple of (best matching MP or None, confidence between 0 and 1)
+        """
+        return self.match_name_scored(
+            statement.mp_name, mps, role=role, phonetic_fallback=phonetic_fallback
+        )
+
+    def collapse_speaker_aliases(
+        self,
+        statements: List[Statement],
+        mps: List[Dict],
+        threshold: float = DEFAULT_CONFIDENCE_THRESHOLD
+    ) -> Tuple[List[Statement], int]:
+        """
+        Re-attribute misspelled speaker names to the canonical MP name.
+
+        OCR can spell the same MP differently within one sitting, which
+        inflates speaker counts. Each distinct speaker name is matched
+        against mps; when the match is confident and the names differ, the
+        statement is rewritten to use the MP's normalized name.
+
+        Args:
+            statements: Statements from one sitting
+            mps: List of MP dictionaries with at least a 'name' key
+            threshold: Minimum confidence needed to re-attribute a name
+
+        Returns:
+            Tuple of (new list of statements, number of statements re-attributed)
+        """
+        canonical: Dict[str, Optional[str]] = {}
+        collapsed = []
+        reattributed = 0
+
+        for statement in statements:
+            if statement.mp_name not in canonical:
+                mp, confidence = self.match_statement_to_mp_scored(statement, mps)
+                canonical[statement.mp_name] = (
+                    self.identifier.normalize_mp_name(mp['name'])
+                    if mp is not None and confidence >= threshold else None
+                )
+
+            name = canonical[statement.mp_name]
+            if name and name != statement.mp_name:
+                logger.debug(f"Re-attributing '{statement.mp_name}' to '{name}'")
+                statement = replace(statement, mp_name=name)
+                reattributed += 1
+
+            collapsed.append(statement)
+
+        if reattributed:
+            logger.info(f"Re-attributed {reattributed} statements to canonical MP names")
+
+        return collapsed, reattributed
@@ -12,12 +12,17 @@ Usage:
 import argparse
 import json
 import logging
+import re
 import sys
+from abc import ABC, abstractmethod
+from collections import Counter
 from pathlib import Path
-from typing import Dict, List, Optional
+from typing import BinaryIO, Dict, List, Optional
 
 import pdfplumber
 
+from hansard_tales.processors.sitting_parser import SittingParser
+
 
 # Configure logging
 logging.basicConfig(
@@ -27,6 +32,119 @@ logging.basicConfig(
 logger = logging.getLogger(__name__)
 
 
+class PDFTextExtractor(ABC):
+    """Interface for turning a PDF into plain text.
+    
+    Implementations can wrap pdfplumber, an external tool such as pdftotext,
+    or a cloud OCR service. Register them with register_text_extractor so the
+    rest of the pipeline can select one by name.
+    """
+    
+    @abstractmethod
+    def extract(self, stream: BinaryIO) -> str:
+        """
+        Extract the full text of a PDF.
+        
+        Args:
+            stream: Binary stream positioned at the start of the PDF
+            
+        Returns:
+            Extracted text with pages separated by blank lines
+        """
+
+
+class PdfplumberTextExtractor(PDFTextExtractor):
+    """Extracts text locally with pdfplumber (the default)."""
+    
+    def extract(self, stream: BinaryIO) -> str:
+        """Extract text from every page with pdfplumber."""
+        with pdfplumber.open(stream) as pdf:
+            pages = [(page.extract_text() or '').strip() for page in pdf.pages]
+        
+        return "\n\n".join(page for page in pages if page)
+
+
+class NullTextExtractor(PDFTextExtractor):
+    """Stub extractor that returns no text, for tests and dry runs."""
+    
+    def extract(self, stream: BinaryIO) -> str:
+        """Return an empty string without reading the stream."""
+        return ""
+
+
+DEFAULT_TEXT_EXTRACTOR = 'pdfplumber'
+
+# Share of pages that must start with the same line for it to be a running header
+RUNNING_HEADER_MIN_SHARE = 0.5
+
+_TEXT_EXTRACTORS: Dict[str, PDFTextExtractor] = {
+    'pdfplumber': PdfplumberTextExtractor(),
+    'null': NullTextExtractor(),
+}
+
+
+def register_text_extractor(name: str, extractor: PDFTextExtractor) -> None:
+    """
+    Register a PDF text extractor under a name, replacing any existing one.
+    
+    Args:
+        name: Name used to select the extractor
+        extractor: Extractor instance
+    """
+    if not isinstance(extractor, PDFTextExtractor):
+        raise TypeError(f"Extractor must implement PDFTextExtractor, got {type(extractor).__name__}")
+    
+    _TEXT_EXTRACTORS[name] = extractor
+
+
+def get_text_extractor(name: str = DEFAULT_TEXT_EXTRACTOR) -> PDFTextExtractor:
+    """
+    Look up a registered PDF text extractor.
+    
+    Args:
+        name: Registered extractor name
+        
+    Returns:
+        Extractor instance
+        
+    Raises:
+        KeyError: If no extractor is registered under the name
+    """
+    try:
+        return _TEXT_EXTRACTORS[name]
+    except KeyError:
+        raise KeyError(f"No PDF text extractor registered as '{name}'") from None
+
+
+def load_session_from_pdf(
+    stream: BinaryIO,
+    extractor_name: str = DEFAULT_TEXT_EXTRACTOR,
+    **metadata
+) -> Dict:
+    """
+    Build a session record from a Hansard PDF.
+    
+    Args:
+        stream: Binary stream of the PDF
+        extractor_name: Registered text extractor to use
+        **metadata: Known session fields (e.g., date, title, pdf_url)
+        
+    Returns:
+        Session dictionary with the given metadata, the extracted 'text',
+        and 'volume'/'number' when the header contains them
+    """
+    text = get_text_extractor(extractor_name).extract(stream)
+    
+    session = dict(metadata)
+    session['text'] = text
+    
+    volume_info = SittingParser().extract_volume_info(text)
+    if volume_info:
+        session['volume'], session['number'] = volume_info
+    
+    return session
+
+
 class PDFProcessor:
     """Processor for extracting text from Hansard PDF files."""
     
@@ -146,6 +264,73 @@ class PDFProcessor:
             if page['text']
         )
     
+    @staticmethod
+    def _header_key(line: str) -> str:
+        """Build a comparison key for a header line that ignores page numbers."""
+        return re.sub(r'\d+', '#', ' '.join(line.split()))
+    
+    @staticmethod
+    def _first_line(page: str) -> str:
+        """Return the first non-blank line of a page."""
+        return next((line for line in page.splitlines() if line.strip()), '')
+    
+    def detect_running_header(self, pages: List[str]) -> str:
+        """
+        Find the header line repeated at the top of the pages.
+        
+        Pages are compared by their first non-blank line with digits
+        ignored, so headers that differ only in page number (or date) are
+        treated as the same. A line counts as the running header when it
+        starts at least RUNNING_HEADER_MIN_SHARE of the pages (and at least
+        two pages).
+        
+        Args:
+            pages: Text of each page
+            
+        Returns:
+            The first occurrence of the detected header, or an empty string
+            if the pages have no running header
+        """
+        first_lines = [self._first_line(page) for page in pages]
+        keys = Counter(self._header_key(line) for line in first_lines if line.strip())
+        if not keys:
+            return ''
+        
+        key, count = keys.most_common(1)[0]
+        if count < 2 or count < len(pages) * RUNNING_HEADER_MIN_SHARE:
+            return ''
+        
+        return next(line.strip() for line in first_lines if self._header_key(line) == key)
+    
+    def strip_running_header(self, pages: List[str], header: Optional[str] = None) -> List[str]:
+        """
+        Remove a running header from the top of each page.
+        
+        Args:
+            pages: Text of each page
+            header: Header to remove, e.g. as returned (and inspected) from
+                detect_running_header; detected from the pages if None
+            
+        Returns:
+            Page texts with the header line removed where it appears
+        """
+        if header is None:
+            header = self.detect_running_header(pages)
+        if not header:
+            return list(pages)
+        
+        key = self._header_key(header)
+        stripped = []
+        
+        for page in pages:
+            lines = page.splitlines()
+            first = next((i for i, line in enumerate(lines) if line.strip()), None)
+            if first is not None and self._header_key(lines[first]) == key:
+                page = '\n'.join(lines[first + 1:]).strip()
+            stripped.append(page)
+        
+        return stripped
+    
     def get_page_text(self, extracted_data: Dict, page_number: int) -> Optional[str]:
         """
         Get text from a specific page.
@@ -0,0 +1,896 @@
+#!/usr/bin/env python3
+"""
+Order-of-business section parsing for Hansard documents.
+
+A sitting's proceedings are divided into sections with upper-case headings
+such as "MOTIONS", "STATEMENTS" and "NOTICES OF MOTION". This module locates
+those sections and extracts the structured business recorded in them.
+
+Usage:
+    from hansard_tales.processors.section_parser import SectionParser
+
+    parser = SectionParser()
+    motions = parser.parse_motions(hansard_text)
+    questions = parser.parse_questions(hansard_text)
+    supplementaries = parser.parse_supplementaries(hansard_text)
+    reports = parser.parse_committee_reports(hansard_text, committees)
+    debate = parser.parse_adjournment_debate(hansard_text)
+    rulings = parser.parse_chair_communications(hansard_text)
+"""
+
+import logging
+import re
+from dataclasses import dataclass
+from typing import Dict, List, Optional, Tuple
+
+from hansard_tales.processors.mp_identifier import HEADING_PATTERN, MPIdentifier
+
+
+# Configure logging
+logging.basicConfig(
+    level=logging.INFO,
+    format='%(asctime)s - %(levelname)s - %(message)s'
+)
+logger = logging.getLogger(__name__)
+
+
+# Question types, taken from the subsection a question is printed under
+QUESTION_ORAL = 'oral'
+QUESTION_WRITTEN = 'written'
+QUESTION_PRIVATE_NOTICE = 'private_notice'
+
+# Canonical ministry names by lowercase variant. Ministries are regularly
+# renamed and merged; add entries here to fold old names into current ones.
+MINISTRY_ALIASES = {
+    'health': 'Health',
+    'medical services': 'Health',
+    'education': 'Education',
+    'education, science and technology': 'Education',
+    'interior': 'Interior and National Administration',
+    'interior and coordination of national government': 'Interior and National Administration',
+    'interior and national administration': 'Interior and National Administration',
+    'treasury': 'National Treasury',
+    'finance': 'National Treasury',
+    'national treasury': 'National Treasury',
+    'national treasury and planning': 'National Treasury',
+    'national treasury and economic planning': 'National Treasury',
+    'agriculture': 'Agriculture and Livestock Development',
+    'agriculture, livestock and fisheries': 'Agriculture and Livestock Development',
+    'agriculture and livestock development': 'Agriculture and Livestock Development',
+    'roads': 'Roads and Transport',
+    'transport': 'Roads and Transport',
+    'roads and transport': 'Roads and Transport',
+    'transport, infrastructure, housing, urban development and public works': 'Roads and Transport',
+    'defence': 'Defence',
+    'foreign affairs': 'Foreign and Diaspora Affairs',
+    'foreign and diaspora affairs': 'Foreign and Diaspora Affairs',
+}
+
+# Role wording around a ministry's subject: "Cabinet Secretary for ...",
+# "Ministry of ...", "... Ministry"
+MINISTRY_PREFIX_PATTERN = re.compile(
+    r'^(?:the\s+)?(?:Cabinet\s+Secretary\s*(?:,\s*|\s+for\s+))?(?:the\s+)?'
+    r'(?:Ministry\s+(?:of|for)\s+)?(?:the\s+)?',
+    re.IGNORECASE
+)
+MINISTRY_SUFFIX_PATTERN = re.compile(r'\s+Ministry$', re.IGNORECASE)
+
+# Words left lowercase when title-casing an unknown ministry
+MINISTRY_MINOR_WORDS = {'and', 'of', 'for', 'the', 'in'}
+
+
+def normalize_ministry(raw: str) -> str:
+    """
+    Map a ministry name or role label to its canonical ministry name.
+
+    "Ministry of Health", "Health Ministry" and "Cabinet Secretary for
+    Health" all give "Health". Variants listed in MINISTRY_ALIASES map to
+    their canonical name; other ministries are title-cased.
+
+    Args:
+        raw: Ministry name as written
+
+    Returns:
+        Canonical ministry name, or an empty string for empty input
+    """
+    name = ' '.join((raw or '').split())
+    name = MINISTRY_PREFIX_PATTERN.sub('', name)
+    name = MINISTRY_SUFFIX_PATTERN.sub('', name).strip(' ,')
+
+    if name.lower() in MINISTRY_ALIASES:
+        return MINISTRY_ALIASES[name.lower()]
+
+    return ' '.join(
+        word.lower() if i > 0 and word.lower() in MINISTRY_MINOR_WORDS else word[:1].upper() + word[1:]
+        for i, word in enumerate(name.split())
+    )
+
+
+@dataclass
+class Motion:
+    """Represents a motion moved in the House."""
+    text: str
+    mover: str = ""
+    seconder: str = ""
+
+
+@dataclass
+class MinisterialStatement:
+    """Represents a statement made by a Cabinet Secretary."""
+    ministry: str
+    secretary: str
+    text: str
+
+
+@dataclass
+class Notice:
+    """Represents a notice of motion given by an MP."""
+    sponsor: str
+    subject: str
+
+
+@dataclass
+class Question:
+    """Represents a question put to the Executive and its answer."""
+    question_type: str
+    number: str
+    asker: str
+    text: str
+    answer: str = ""
+
+
+@dataclass
+class Supplementary:
+    """Represents a supplementary question following a numbered question."""
+    question_number: str
+    asker: str
+    text: str
+
+
+@dataclass
+class CommitteeReport:
+    """Represents a committee report laid on the Table of the House."""
+    committee: str
+    title: str
+    committee_id: Optional[object] = None
+
+
+@dataclass
+class AdjournmentDebate:
+    """Represents an adjournment debate on a matter raised by one MP."""
+    mp: str
+    topic: str
+
+
+@dataclass
+class Communication:
+    """Represents a communication from the Chair, such as a ruling."""
+    subject: str
+    text: str
+
+
+@dataclass
+class TOCEntry:
+    """Represents a line of an Official Report's table of contents."""
+    title: str
+    page: int
+
+
+class SectionParser:
+    """Extracts order-of-business sections from Hansard text."""
+
+    # Top-level order-of-business headings. Business items within a section
+    # ("ADOPTION OF REPORT ON ...") are also printed in capitals, so only
+    # these headings end a section.
+    SECTION_HEADINGS = {
+        'PRAYERS', 'COMMUNICATION FROM THE CHAIR', 'COMMUNICATIONS FROM THE CHAIR',
+        'MESSAGES', 'PETITIONS', 'PAPERS', 'PAPERS LAID', 'NOTICES OF MOTION',
+        'NOTICE OF MOTION', 'QUESTIONS AND STATEMENTS', 'QUESTIONS', 'STATEMENTS',
+        'ORAL ANSWERS TO QUESTIONS', 'MOTIONS', 'MOTION', 'BILLS',
+        'COMMITTEE OF THE WHOLE HOUSE', 'POINTS OF ORDER', 'ADJOURNMENT',
+    }
+
+    # Subsection headings that determine a question's type
+    QUESTION_TYPE_HEADINGS = {
+        'ORAL ANSWERS TO QUESTIONS': QUESTION_ORAL,
+        'QUESTIONS FOR ORAL ANSWER': QUESTION_ORAL,
+        'WRITTEN ANSWERS TO QUESTIONS': QUESTION_WRITTEN,
+        'QUESTIONS FOR WRITTEN ANSWER': QUESTION_WRITTEN,
+        'QUESTION BY PRIVATE NOTICE': QUESTION_PRIVATE_NOTICE,
+        'QUESTIONS BY PRIVATE NOTICE': QUESTION_PRIVATE_NOTICE,
+        'PRIVATE NOTICE QUESTION': QUESTION_PRIVATE_NOTICE,
+        'PRIVATE NOTICE QUESTIONS': QUESTION_PRIVATE_NOTICE,
+    }
+
+    # An item of business heading, which unlike a section heading may carry
+    # a year or number, e.g. "THE FINANCE BILL, 2024"
+    BUSINESS_HEADING_PATTERN = re.compile(r"^[ \t]*([A-Z][A-Z0-9'’,&()\- ]*[A-Z0-9)])[ \t]*$", re.MULTILINE)
+
+    # Motions are recorded as "THAT, this House ..."
+    MOTION_START_PATTERN = re.compile(r'^[ \t]*THAT\b,?', re.MULTILINE)
+
+    # "(Hon. John Mbadi) seconded by (Hon. Aden Duale)", parentheses optional
+    MOVER_PATTERN = re.compile(
+        r'\(?\s*(?:Moved\s+by\s+)?Hon\.?\s+(?P<mover>[^()\n]+?)\s*\)?\s*,?\s*'
+        r'seconded\s+by\s+\(?\s*Hon\.?\s+(?P<seconder>[^()\n]+?)\s*(?:\)|$)',
+        re.IGNORECASE | re.MULTILINE
+    )
+
+    # "(Hon. John Mbadi)" closing a motion with no seconder recorded
+    SOLE_MOVER_PATTERN = re.compile(
+        r'\(\s*(?:Moved\s+by\s+)?Hon\.?\s+(?P<mover>[^()\n]+?)\s*\)\s*\Z',
+        re.IGNORECASE
+    )
+
+    # "The Cabinet Secretary for Health (Hon. Susan Nakhumicha):" or
+    # "The Cabinet Secretary, Ministry of Health (Hon. Susan Nakhumicha):"
+    CABINET_SECRETARY_PATTERN = re.compile(
+        r'The\s+Cabinet\s+Secretary\s*(?:,\s*|\s+for\s+)(?:(?:the\s+)?Ministry\s+of\s+)?'
+        r'(?P<ministry>[^():\n]+?)\s*'
+        r'\(\s*(?:Hon\.?\s+)?(?P<name>(?:\([^()\n]*\)\s*)?[^()\n]+?)\s*\)\s*:'
+    )
+
+    # "I beg to give notice of the following Motion:"
+    GIVE_NOTICE_PATTERN = re.compile(
+        r'\bgive\s+notice\b(?:\s+of\b)?(?:\s+the\s+following\s+Motion\s*:)?',
+        re.IGNORECASE
+    )
+
+    # "Question No.045/2023" at the start of a line
+    QUESTION_NUMBER_PATTERN = re.compile(
+        r'^[ \t]*Question\s+No\.?\s*(?P<number>\d+(?:/\d+)?)',
+        re.IGNORECASE | re.MULTILINE
+    )
+
+    # "Hon. John Mbadi (Suba South, ODM) asked the Cabinet Secretary ..."
+    ASKED_PATTERN = re.compile(
+        r'Hon\.?\s+(?P<name>[^():\n]+?)\s*(?:\([^()\n]*\)\s*)?asked\b'
+    )
+
+    # A follow-up is a supplementary if it asks something or says it is one
+    SUPPLEMENTARY_PATTERN = re.compile(r'\?|\bsupplementary\b', re.IGNORECASE)
+
+    # "CONTENTS" heading above the table of contents
+    CONTENTS_HEADING_PATTERN = re.compile(r'^[ \t]*(?:TABLE\s+OF\s+)?CONTENTS[ \t]*$', re.MULTILINE)
+
+    # "Papers Laid .............. 3" - dotted leader optional
+    TOC_ENTRY_PATTERN = re.compile(
+        r'^[ \t]*(?P<title>\S.*?)(?:\s*(?:\.[ \t]*){2,}|[ \t]{2,}|[ \t]*…+[ \t]*)(?P<page>\d{1,4})[ \t]*$',
+        re.MULTILINE
+    )
+
+    # "Report of the Departmental Committee on Health on ..." up to the end
+    # of the paper's sentence or "on the Table of the House"
+    COMMITTEE_REPORT_PATTERN = re.compile(
+        r"\b(?:[Tt]he\s+)?(?P<title>Report\s+of\s+the\s+"
+        r"(?P<committee>(?:[A-Z][\w’'\-]*\s+(?:(?:and|of)\s+)?)*?Committee"
+        r"(?:\s+on\s+[A-Z][\w’'\-]*(?:\s+(?:(?:and|of|the|&)\s+)?[A-Z][\w’'\-]*)*)?)"
+        r".*?)(?=\s+on\s+the\s+Table\b|\.\s*(?:\n|$)|\n\s*\n|$)",
+        re.DOTALL
+    )
+
+    # Heading of an adjournment (half-hour) debate, e.g. "ADJOURNMENT MOTION"
+    # or "MOTION FOR ADJOURNMENT UNDER STANDING ORDER 33"
+    ADJOURNMENT_DEBATE_HEADING_PATTERN = re.compile(
+        r'ADJOURNMENT\s+MOTION|MOTION\s+FOR\s+(?:THE\s+)?ADJOURNMENT(?:\s+UNDER\s+STANDING\s+ORDER\s+\d+)?'
+        r'|HALF[\s-]HOUR\s+DEBATE'
+    )
+
+    # "... to discuss a matter of urgent national importance, namely, insecurity in ..."
+    NAMELY_PATTERN = re.compile(r'\bnamely\b\s*[:,\-]?\s*(?P<topic>.+?)(?:\.(?:\s|$)|\n\s*\n|$)', re.DOTALL)
+
+    # Presiding officer's label opening a communication, e.g. "Hon. Speaker:"
+    # or "The Speaker (Hon. Moses Wetang'ula):"
+    CHAIR_LABEL_PATTERN = re.compile(
+        r"^[ \t]*(?:Hon\.?|The|Mr\.?|Madam)\s+(?:(?:Deputy|Temporary)\s+)?(?:Speaker|Chairperson)"
+        r"\s*(?:\([^()\n]*\))?\s*:",
+        re.MULTILINE
+    )
+
+    def __init__(self):
+        """Initialize the section parser."""
+        self.identifier = MPIdentifier()
+
+    def extract_section(self, text: str, heading: str) -> Optional[str]:
+        """
+        Extract the body of a section by its heading.
+
+        The section runs from the line after the heading to the next
+        heading in SECTION_HEADINGS (or the end of the text).
+
+        Args:
+            text: Hansard text
+            heading: Section heading, matched case-insensitively
+
+        Returns:
+            Section body, or None if the heading does not appear
+        """
+        if not text:
+            return None
+
+        wanted = ' '.join(heading.upper().split())
+        headings = [
+            (match, ' '.join(match.group(1).split()))
+            for match in HEADING_PATTERN.finditer(text)
+        ]
+
+        for i, (match, name) in enumerate(headings):
+            if name != wanted:
+                continue
+
+            end = next(
+                (later.start() for later, later_name in headings[i + 1:]
+                 if later_name in self.SECTION_HEADINGS),
+                len(text)
+            )
+            return text[match.end():end].strip()
+
+        logger.debug(f"Section '{heading}' not found")
+        return None
+
+    def heading_positions(self, text: str) -> List[int]:
+        """
+        Find where each item of business starts.
+
+        Every upper-case heading counts, including business items within a
+        section such as a Bill's title and year, so the positions can be
+        used to split the sitting into debates.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            Offsets of the headings, in order
+        """
+        if not text:
+            return []
+
+        return [match.start() for match in self.BUSINESS_HEADING_PATTERN.finditer(text)]
+
+    def parse_table_of_contents(self, text: str) -> List[TOCEntry]:
+        """
+        Extract the table of contents printed at the front of a report.
+
+        Entries are read from the CONTENTS heading onwards and end at the
+        first line that is not an entry. Each entry is a title followed by
+        a page number, usually separated by a dotted leader; a title wrapped
+        onto a second line is joined back together.
+
+        Args:
+            text: Hansard text (the opening pages are sufficient)
+
+        Returns:
+            List of TOCEntry objects in printed order; empty if the report
+            has no table of contents
+        """
+        if not text:
+            return []
+
+        heading = self.CONTENTS_HEADING_PATTERN.search(text)
+        if not heading:
+            logger.debug("No table of contents found")
+            return []
+
+        lines = [line for line in text[heading.end():].splitlines() if line.strip()]
+        entries = []
+        i = 0
+
+        while i < len(lines):
+            match = self.TOC_ENTRY_PATTERN.match(lines[i])
+            if not match and i + 1 < len(lines):
+                # A long title wrapped onto the next line
+                match = self.TOC_ENTRY_PATTERN.match(lines[i].strip() + ' ' + lines[i + 1].strip())
+                i += 1
+            if not match:
+                break
+            i += 1
+
+            entries.append(TOCEntry(
+                title=' '.join(match.group('title').split()),
+                page=int(match.group('page'))
+            ))
+
+        return entries
+
+    def _display_name(self, name: str) -> str:
+        """Normalize an MP name and case it for display ("Ichung'wah")."""
+        return self.identifier.title_case_name(self.identifier.normalize_mp_name(name))
+
+    def _parse_motion(self, block: str) -> Motion:
+        """Split a single motion block into its text, mover and seconder."""
+        mover = seconder = ""
+
+        match = self.MOVER_PATTERN.search(block) or self.SOLE_MOVER_PATTERN.search(block)
+        if match:
+            mover = match.group('mover')
+            if 'seconder' in match.groupdict():
+                seconder = match.group('seconder')
+            block = block[:match.start()] + block[match.end():]
+
+        return Motion(
+            text=' '.join(block.split()),
+            mover=self._display_name(mover) if mover else "",
+            seconder=self._display_name(seconder) if seconder else ""
+        )
+
+    def parse_motions(self, text: str) -> List[Motion]:
+        """
+        Extract motions with their mover and seconder.
+
+        Only the MOTIONS section is searched when the text has one; otherwise
+        the whole text is treated as motion business. Each motion starts at a
+        line beginning "THAT". Motions without a recorded seconder have an
+        empty seconder.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of Motion objects in the order they appear
+        """
+        if not text:
+            return []
+
+        for heading in ('MOTIONS', 'MOTION'):
+            section = self.extract_section(text, heading)
+            if section is not None:
+                text = section
+                break
+
+        starts = [match.start() for match in self.MOTION_START_PATTERN.finditer(text)]
+        motions = []
+
+        for i, start in enumerate(starts):
+            end = starts[i + 1] if i + 1 < len(starts) else len(text)
+            block = text[start:end]
+
+            # The next motion's title heading is not part of this motion
+            heading = HEADING_PATTERN.search(block, 1)
+            if heading:
+                block = block[:heading.start()]
+
+            motions.append(self._parse_motion(block))
+
+        logger.debug(f"Found {len(motions)} motions")
+        return motions
+
+    def parse_ministerial_statements(self, text: str) -> List[MinisterialStatement]:
+        """
+        Extract statements made by Cabinet Secretaries.
+
+        Only the STATEMENTS section is searched when the text has one. Each
+        statement runs from a Cabinet Secretary's role label to the next
+        speaker. Ministries are given by their canonical name (see
+        normalize_ministry).
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of MinisterialStatement objects in the order they appear
+        """
+        if not text:
+            return []
+
+        for heading in ('STATEMENTS', 'QUESTIONS AND STATEMENTS'):
+            section = self.extract_section(text, heading)
+            if section is not None:
+                text = section
+                break
+
+        labels = list(self.CABINET_SECRETARY_PATTERN.finditer(text))
+        boundaries = sorted(
+            {start for _, start, _ in self.identifier.find_all_speakers(text)}
+            | {label.start() for label in labels}
+        )
+
+        statements = []
+        for label in labels:
+            next_pos = next((pos for pos in boundaries if pos >= label.end()), None)
+            statements.append(MinisterialStatement(
+                ministry=normalize_ministry(label.group('ministry')),
+                secretary=self._display_name(label.group('name')),
+                text=self.identifier.extract_statement_text(text, label.end(), next_pos)
+            ))
+
+        logger.debug(f"Found {len(statements)} ministerial statements")
+        return statements
+
+    def parse_notices_of_motion(self, text: str) -> List[Notice]:
+        """
+        Extract notices of motion and the MPs who gave them.
+
+        Only the NOTICES OF MOTION section is searched when the text has
+        one. The subject is the title printed above the notice; when there
+        is no title, the text of the proposed motion is used.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of Notice objects in the order they appear
+        """
+        if not text:
+            return []
+
+        for heading in ('NOTICES OF MOTION', 'NOTICE OF MOTION'):
+            section = self.extract_section(text, heading)
+            if section is not None:
+                text = section
+                break
+
+        speakers = self.identifier.find_all_speakers(text)
+        notices = []
+        previous_end = 0
+
+        for i, (name, start, end) in enumerate(speakers):
+            next_pos = speakers[i + 1][1] if i + 1 < len(speakers) else None
+            body = self.identifier.extract_statement_text(text, end, next_pos)
+            preamble = text[previous_end:start]
+            previous_end = end
+
+            if name in self.identifier.NON_MP_SPEAKERS:
+                continue
+
+            match = self.GIVE_NOTICE_PATTERN.search(body)
+            if not match:
+                continue
+
+            titles = [m.group(1) for m in HEADING_PATTERN.finditer(preamble)]
+            if titles:
+                subject = titles[-1]
+            else:
+                subject = body[match.end():]
+
+            # A following title belongs to the next notice
+            next_title = HEADING_PATTERN.search(subject, 1)
+            if next_title:
+                subject = subject[:next_title.start()]
+
+            notices.append(Notice(
+                sponsor=self.identifier.normalize_mp_name(name),
+                subject=' '.join(subject.split())
+            ))
+
+        logger.debug(f"Found {len(notices)} notices of motion")
+        return notices
+
+    def parse_adjournment_debate(self, text: str) -> Optional[AdjournmentDebate]:
+        """
+        Extract the MP and subject of an adjournment debate.
+
+        The debate starts at an adjournment motion heading. The subject is
+        the title printed under the heading or, without a title, the matter
+        the mover names ("..., namely, ..."). The MP is the first speaker
+        who is not presiding.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            AdjournmentDebate, or None if the sitting has no adjournment
+            debate or its mover cannot be found
+        """
+        if not text:
+            return None
+
+        headings = list(self.BUSINESS_HEADING_PATTERN.finditer(text))
+        start = next(
+            (i for i, match in enumerate(headings)
+             if self.ADJOURNMENT_DEBATE_HEADING_PATTERN.fullmatch(' '.join(match.group(1).split()))),
+            None
+        )
+        if start is None:
+            logger.debug("No adjournment debate found")
+            return None
+
+        end = next(
+            (match.start() for match in headings[start + 1:]
+             if ' '.join(match.group(1).split()) in self.SECTION_HEADINGS),
+            len(text)
+        )
+        body = text[headings[start].end():end]
+
+        speakers = [
+            speaker for speaker in self.identifier.find_all_speakers(body)
+            if speaker[0] not in self.identifier.NON_MP_SPEAKERS
+        ]
+        if not speakers:
+            return None
+
+        name, speaker_start, speaker_end = speakers[0]
+
+        titles = [match.group(1) for match in self.BUSINESS_HEADING_PATTERN.finditer(body[:speaker_start])]
+        if titles:
+            topic = titles[0]
+        else:
+            next_pos = next(
+                (pos for _, pos, _ in self.identifier.find_all_speakers(body) if pos > speaker_start),
+                None
+            )
+            match = self.NAMELY_PATTERN.search(
+                self.identifier.extract_statement_text(body, speaker_end, next_pos)
+            )
+            topic = match.group('topic') if match else ''
+
+        return AdjournmentDebate(
+            mp=self.identifier.normalize_mp_name(name),
+            topic=' '.join(topic.split()).rstrip(',;')
+        )
+
+    def _communication_text(self, block: str) -> str:
+        """Extract the Chair's words from a communication block."""
+        label = self.CHAIR_LABEL_PATTERN.search(block)
+        start = label.end() if label else 0
+
+        # Members' responses that follow are not part of the communication
+        next_pos = next(
+            (pos for name, pos, _ in self.identifier.find_all_speakers(block)
+             if pos >= start and name != 'Speaker' and name not in self.identifier.NON_MP_SPEAKERS),
+            None
+        )
+        return ' '.join(self.identifier.extract_statement_text(block, start, next_pos).split())
+
+    def parse_chair_communications(self, text: str) -> List[Communication]:
+        """
+        Extract communications from the Chair, such as rulings and
+        announcements of visitors.
+
+        Only the COMMUNICATION FROM THE CHAIR section is searched. Each
+        upper-case title within the section starts a communication and
+        gives its subject; a communication printed without a title has an
+        empty subject. The text is the Chair's address, without the
+        presiding officer's label or any Members' responses.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of Communication objects in the order they appear; empty
+            if the sitting has no communications from the Chair
+        """
+        if not text:
+            return []
+
+        for heading in ('COMMUNICATION FROM THE CHAIR', 'COMMUNICATIONS FROM THE CHAIR'):
+            section = self.extract_section(text, heading)
+            if section is not None:
+                break
+        else:
+            logger.debug("No communications from the Chair found")
+            return []
+
+        titles = list(HEADING_PATTERN.finditer(section))
+        blocks = []
+        if not titles or section[:titles[0].start()].strip():
+            blocks.append(('', section[:titles[0].start()] if titles else section))
+        for i, title in enumerate(titles):
+            end = titles[i + 1].start() if i + 1 < len(titles) else len(section)
+            blocks.append((' '.join(title.group(1).split()), section[title.end():end]))
+
+        communications = [
+            Communication(subject=subject, text=self._communication_text(block))
+            for subject, block in blocks
+        ]
+        communications = [
+            communication for communication in communications
+            if communication.subject or communication.text
+        ]
+
+        logger.debug(f"Found {len(communications)} communications from the Chair")
+        return communications
+
+    @staticmethod
+    def _committee_key(name: str) -> str:
+        """Build a comparison key for a committee name."""
+        name = ' '.join(name.lower().split())
+        return re.sub(r'^the\s+', '', name)
+
+    def parse_committee_reports(
+        self,
+        text: str,
+        committees: Optional[List[Dict]] = None
+    ) -> List[CommitteeReport]:
+        """
+        Extract committee reports laid on the Table.
+
+        Only the PAPERS LAID section is searched when the text has one.
+        Papers from bodies other than committees, such as the
+        Auditor-General, are not committee reports and are skipped.
+
+        Args:
+            text: Hansard text
+            committees: Optional committee dictionaries with 'id' and
+                'name'; a report whose committee matches a name (ignoring
+                case and a leading "The") gets that committee's id
+
+        Returns:
+            List of CommitteeReport objects in the order they appear
+        """
+        if not text:
+            return []
+
+        for heading in ('PAPERS LAID', 'PAPERS'):
+            section = self.extract_section(text, heading)
+            if section is not None:
+                text = section
+                break
+
+        ids_by_name = {
+            self._committee_key(committee['name']): committee.get('id')
+            for committee in committees or []
+            if committee.get('name')
+        }
+
+        reports = []
+        for match in self.COMMITTEE_REPORT_PATTERN.finditer(text):
+            committee = ' '.join(match.group('committee').split())
+            reports.append(CommitteeReport(
+                committee=committee,
+                title=' '.join(match.group('title').split()).rstrip('.'),
+                committee_id=ids_by_name.get(self._committee_key(committee))
+            ))
+
+        logger.debug(f"Found {len(reports)} committee reports")
+        return reports
+
+    def _question_labels(self, block: str) -> Tuple[str, int, List[Tuple[str, int, int]], Optional[int]]:
+        """
+        Find a question block's asker and the contributions that follow it.
+
+        Returns the normalized asker, where the question text starts, the
+        speaker labels after it, and the index among those labels of the
+        answer (None if unanswered).
+        """
+        labels = self.identifier.find_all_speakers(block) + [
+            (label.group('name'), label.start(), label.end())
+            for label in self.CABINET_SECRETARY_PATTERN.finditer(block)
+        ]
+        labels.sort(key=lambda label: label[1])
+
+        asker = ""
+        question_start = 0
+        asked = self.ASKED_PATTERN.search(block)
+        if asked:
+            asker = self.identifier.normalize_mp_name(asked.group('name'))
+            question_start = asked.end()
+
+        later = [label for label in labels if label[1] >= question_start]
+
+        answer_index = None
+        for i, (name, _, _) in enumerate(later):
+            if name in self.identifier.NON_MP_SPEAKERS:
+                continue
+            if asker and self.identifier.normalize_mp_name(name) == asker:
+                continue
+            answer_index = i
+            break
+
+        return asker, question_start, later, answer_index
+
+    def _parse_question(self, block: str, question_type: str, number: str) -> Question:
+        """Split a single question block into its asker, question and answer."""
+        asker, question_start, later, answer_index = self._question_labels(block)
+        question_end = later[0][1] if later else None
+
+        answer = ""
+        if answer_index is not None:
+            next_pos = later[answer_index + 1][1] if answer_index + 1 < len(later) else None
+            answer = self.identifier.extract_statement_text(block, later[answer_index][2], next_pos)
+
+        return Question(
+            question_type=question_type,
+            number=number,
+            asker=asker,
+            text=' '.join(self.identifier.extract_statement_text(block, question_start, question_end).split()),
+            answer=' '.join(answer.split())
+        )
+
+    def _parse_supplementaries(self, block: str, number: str) -> List[Supplementary]:
+        """Extract the supplementary questions put after a question's answer."""
+        _, _, later, answer_index = self._question_labels(block)
+        if answer_index is None:
+            return []
+
+        answerer = self.identifier.normalize_mp_name(later[answer_index][0])
+        supplementaries = []
+
+        for i in range(answer_index + 1, len(later)):
+            name, _, end = later[i]
+            if name in self.identifier.NON_MP_SPEAKERS:
+                continue
+
+            mp = self.identifier.normalize_mp_name(name)
+            if mp == answerer:
+                continue
+
+            next_pos = later[i + 1][1] if i + 1 < len(later) else None
+            body = self.identifier.extract_statement_text(block, end, next_pos)
+            if self.SUPPLEMENTARY_PATTERN.search(body):
+                supplementaries.append(Supplementary(question_number=number, asker=mp, text=' '.join(body.split())))
+
+        return supplementaries
+
+    def _question_blocks(self, text: str) -> List[Tuple[str, str, str]]:
+        """Split text into (question type, number, block) for each numbered question."""
+        type_headings = []
+        boundaries = []
+        for match in HEADING_PATTERN.finditer(text):
+            name = ' '.join(match.group(1).split())
+            if name in self.QUESTION_TYPE_HEADINGS:
+                type_headings.append((match.start(), self.QUESTION_TYPE_HEADINGS[name]))
+            if name in self.SECTION_HEADINGS or name in self.QUESTION_TYPE_HEADINGS:
+                boundaries.append(match.start())
+
+        markers = list(self.QUESTION_NUMBER_PATTERN.finditer(text))
+        boundaries.extend(marker.start() for marker in markers)
+
+        blocks = []
+        for marker in markers:
+            end = min((pos for pos in boundaries if pos > marker.start()), default=len(text))
+
+            question_type = QUESTION_ORAL
+            for pos, heading_type in type_headings:
+                if pos < marker.start():
+                    question_type = heading_type
+
+            blocks.append((question_type, marker.group('number'), text[marker.end():end]))
+
+        return blocks
+
+    def parse_questions(self, text: str) -> List[Question]:
+        """
+        Extract numbered questions with their type and answer.
+
+        Each question runs from its "Question No." line to the next question
+        or top-level section heading. Its type comes from the nearest
+        preceding subsection heading in QUESTION_TYPE_HEADINGS; questions
+        printed before any such heading are treated as oral. The answer is
+        the first contribution after the question by someone other than the
+        asker or the Chair.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of Question objects in the order they appear
+        """
+        if not text:
+            return []
+
+        questions = [
+            self._parse_question(block, question_type, number)
+            for question_type, number, block in self._question_blocks(text)
+        ]
+
+        logger.debug(f"Found {len(questions)} questions")
+        return questions
+
+    def parse_supplementaries(self, text: str) -> List[Supplementary]:
+        """
+        Extract supplementary questions put after numbered questions.
+
+        Questions are found as in parse_questions. After a question's
+        answer, each contribution by a Member other than the one answering
+        or the Chair is a supplementary if it asks a question or says it is
+        a supplementary; remarks such as "Thank you" are not. The original
+        asker's follow-ups count too.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of Supplementary objects in the order they appear, each
+            with the number of the question it follows
+        """
+        if not text:
+            return []
+
+        supplementaries = [
+            supplementary
+            for _, number, block in self._question_blocks(text)
+            for supplementary in self._parse_supplementaries(block, number)
+        ]
+
+        logger.debug(f"Found {len(supplementaries)} supplementary questions")
+        return supplementaries
@@ -0,0 +1,463 @@
+#!/usr/bin/env python3
+"""
+Sitting-level metadata extraction for Hansard documents.
+
+This module extracts information about a sitting as a whole, as opposed to
+individual MP statements, such as the Official Report volume and number
+printed in the document header, the sitting date, the officers of the
+House on duty, the opening prayers, and changes of presiding officer.
+
+Usage:
+    from hansard_tales.processors.sitting_parser import SittingParser
+
+    parser = SittingParser()
+    volume_info = parser.extract_volume_info(hansard_text)
+    sitting_date = parser.extract_sitting_date(hansard_text)
+    closures = parser.detect_closure_motions(hansard_text)
+"""
+
+import logging
+import re
+from dataclasses import dataclass
+from typing import Dict, List, Optional, Tuple
+
+from hansard_tales.dates import MONTHS, WEEKDAYS, parse_textual_date
+from hansard_tales.processors.mp_identifier import MPIdentifier
+
+
+# Configure logging
+logging.basicConfig(
+    level=logging.INFO,
+    format='%(asctime)s - %(levelname)s - %(message)s'
+)
+logger = logging.getLogger(__name__)
+
+
+# Adjournment types returned by SittingParser.detect_adjournment
+ADJOURNMENT_ORDINARY = 'ordinary'
+ADJOURNMENT_SINE_DIE = 'sine_die'
+
+# Vote results returned by SittingParser.detect_vote_outcome
+VOTE_AGREED = 'agreed'
+VOTE_NEGATIVED = 'negatived'
+
+# Prayer types returned by SittingParser.detect_prayer_type
+PRAYER_CHRISTIAN = 'christian'
+PRAYER_MULTI_FAITH = 'multi_faith'
+PRAYER_UNKNOWN = 'unknown'
+
+
+@dataclass
+class ChairEvent:
+    """Represents a presiding officer taking the Chair."""
+    role: str
+    name: str
+    position: int
+
+
+@dataclass
+class VoteOutcome:
+    """Represents how the House decided a Question."""
+    result: Optional[str]
+    recorded: bool
+    ayes: Optional[int] = None
+    noes: Optional[int] = None
+
+
+@dataclass
+class ClosureEvent:
+    """Represents a closure motion cutting debate short."""
+    position: int
+    carried: Optional[bool]
+
+
+class SittingParser:
+    """Extracts sitting-level metadata from Hansard text."""
+
+    # "NATIONAL ASSEMBLY OFFICIAL REPORT ... Vol. III No. 42"
+    VOLUME_PATTERN = re.compile(
+        r'OFFICIAL\s+REPORT\b.{0,300}?'
+        r'\bVol(?:ume|\.)?\s*([IVXLCDM]+|\d+)\s*[,.]?\s*'
+        r'No\.?\s*(\d+)',
+        re.IGNORECASE | re.DOTALL
+    )
+
+    # "The House rose at 6.30 p.m." / "The House is adjourned until ..."
+    ADJOURNMENT_PATTERN = re.compile(
+        r'\b(?:House|Senate|Committee)\s+(?:rose|(?:is|was|stands)\b[\s,\w]{0,25}?\badjourned)\b',
+        re.IGNORECASE
+    )
+
+    SINE_DIE_PATTERN = re.compile(r'\badjourn\w*\s+(?:the\s+House\s+)?sine\s+die\b', re.IGNORECASE)
+
+    # "[The Temporary Deputy Speaker (Hon. (Ms.) Martha Wangari) in the Chair]"
+    CHAIR_PATTERN = re.compile(
+        r'\[\s*(?:The\s+)?'
+        r'(?P<role>(?:Temporary\s+)?(?:Deputy\s+)?(?:Speaker|Chairperson|Chairman|Chairlady))\s*'
+        r'(?:\(\s*(?:Hon\.?\s+)?(?P<name>(?:\([^()]*\)\s*)?[^()\]]+?)\s*\)\s*)?'
+        r'(?:in|took)\s+the\s+Chair\s*\]',
+        re.IGNORECASE
+    )
+
+    # Date line of the sitting header: "Tuesday, 13th February, 2024"
+    SITTING_DATE_PATTERN = re.compile(
+        rf'^[ \t]*(?:{"|".join(WEEKDAYS)})[ \t]*,?[ \t]+\d{{1,2}}(?:st|nd|rd|th)?[ \t]+'
+        rf'(?:{"|".join(MONTHS)})[ \t]*,?[ \t]+\d{{4}}[ \t]*$',
+        re.IGNORECASE | re.MULTILINE
+    )
+
+    # "Clerk at the Table: Mr. Samuel Njoroge" or "Serjeant-at-Arms - Ms. Jane Doe"
+    OFFICER_PATTERN = re.compile(
+        r'^[ \t]*(?:The\s+)?(?P<office>Deputy\s+Clerk|Clerk(?:[\s-]+at[\s-]+the[\s-]+Table)?|'
+        r'Clerk\s+of\s+the\s+(?:National\s+Assembly|Senate)|Ser[jg]eant[\s-]+at[\s-]+Arms)'
+        r'[ \t]*[:\-–—][ \t]*(?P<name>[^\n]*?)[ \t.,;]*$',
+        re.IGNORECASE | re.MULTILINE
+    )
+
+    # "PRAYERS" heading printed at the start of every sitting
+    PRAYERS_PATTERN = re.compile(r'^[ \t]*PRAYERS?\b', re.MULTILINE)
+
+    # "MULTI-FAITH PRAYERS", "(Interfaith prayers were said)"
+    MULTI_FAITH_PATTERN = re.compile(r'\b(?:multi|inter)[\s-]*faith\b', re.IGNORECASE)
+
+    # Prayers are recorded within the opening of the Official Report
+    PRAYER_SEARCH_CHARS = 2000
+
+    # "(Question put and agreed to)" / "(Question put and negatived)"
+    QUESTION_PUT_PATTERN = re.compile(
+        r'\(\s*Question\s+put\s+and\s+(agreed\s+to|negatived)\s*\)', re.IGNORECASE
+    )
+
+    # "I think the Ayes have it"
+    HAVE_IT_PATTERN = re.compile(r'\b(Ayes|Noes)\s+have\s+it\b', re.IGNORECASE)
+
+    # "(The House divided)" or "The results of the Division were as follows"
+    DIVISION_PATTERN = re.compile(
+        r'\b(?:House|Committee)\s+divided\b|\bresults?\s+of\s+the\s+Division\b', re.IGNORECASE
+    )
+
+    # "AYES: 185" / "NOES - 93" in a division result
+    AYES_COUNT_PATTERN = re.compile(r'\bAYES\s*[:\-–]?\s*(\d+)', re.IGNORECASE)
+    NOES_COUNT_PATTERN = re.compile(r'\bNOES\s*[:\-–]?\s*(\d+)', re.IGNORECASE)
+
+    # The Chair calling the House to order: "Order!" or "Hon. Members!", but
+    # not "point of order!"
+    ORDER_CALL_PATTERN = re.compile(r'(?<!\bof\s)\b(?:Order|Hon\.?\s+Members)\s*!', re.IGNORECASE)
+
+    # Speaker labels of the presiding officer: "The Speaker", "Madam Speaker",
+    # "The Temporary Chairperson"
+    PRESIDING_OFFICER_PATTERN = re.compile(r'\b(?:Speaker|Chairperson|Chairman|Chairlady)$', re.IGNORECASE)
+
+    # Closure motion: "I beg to move that the Question be now put" or "...
+    # that the Mover be now called upon to reply"
+    CLOSURE_MOTION_PATTERN = re.compile(
+        r'\bthat\s+the\s+(?:Question\s+be\s+now\s+put|Mover\s+be\s+now\s+called\s+upon\s+to\s+reply)\b',
+        re.IGNORECASE
+    )
+
+    # "(Question, that the Question be now put, put and agreed to)"
+    CLOSURE_DECISION_PATTERN = re.compile(
+        r'\(\s*Question\s*,\s*that\s+the\s+(?:Question\s+be\s+now\s+put|Mover\s+be\s+now\s+called\s+upon\s+to\s+reply)'
+        r'\s*,\s*put\s+and\s+(agreed\s+to|negatived)\s*\)',
+        re.IGNORECASE
+    )
+
+    def __init__(self):
+        """Initialize the sitting parser."""
+        self.identifier = MPIdentifier()
+
+    def extract_volume_info(self, text: str) -> Optional[Tuple[str, str]]:
+        """
+        Extract the Official Report volume and number from the header.
+
+        Args:
+            text: Hansard text (the first page is sufficient)
+
+        Returns:
+            Tuple of (volume, number), or None if no volume line was found
+        """
+        if not text:
+            return None
+
+        match = self.VOLUME_PATTERN.search(text)
+        if not match:
+            logger.debug("No volume information found in text")
+            return None
+
+        volume, number = match.groups()
+        return volume.upper(), number.lstrip('0') or '0'
+
+    def extract_sitting_date(self, text: str) -> Optional[str]:
+        """
+        Extract the sitting's date from the header.
+
+        Only a line giving the weekday and date on its own is used, so dates
+        quoted in debate are not mistaken for it.
+
+        Args:
+            text: Hansard text (the first page is sufficient)
+
+        Returns:
+            Date string in YYYY-MM-DD format, or None if there is no header
+            date line
+
+        Raises:
+            InvalidDateError: If the header's date is not a real date
+        """
+        if not text:
+            return None
+
+        match = self.SITTING_DATE_PATTERN.search(text)
+        if not match:
+            logger.debug("No sitting date found in text")
+            return None
+
+        return parse_textual_date(match.group(0))
+
+    @staticmethod
+    def _canonical_office(office: str) -> str:
+        """Map an office title as printed to its canonical name."""
+        office = ' '.join(re.split(r'[\s-]+', office.lower()))
+        if office.startswith('deputy'):
+            return 'Deputy Clerk'
+        if office.endswith('arms'):
+            return 'Serjeant-at-Arms'
+        if office.endswith('table'):
+            return 'Clerk at the Table'
+        return 'Clerk'
+
+    def extract_officers(self, text: str) -> Dict[str, str]:
+        """
+        Extract the officers of the House named in the sitting record.
+
+        Args:
+            text: Hansard text (the officers appear near the header)
+
+        Returns:
+            Mapping of office ("Clerk", "Deputy Clerk", "Clerk at the Table",
+            "Serjeant-at-Arms") to the officer's name. Where an office is
+            named more than once, the first name is kept.
+        """
+        if not text:
+            return {}
+
+        officers: Dict[str, str] = {}
+        for match in self.OFFICER_PATTERN.finditer(text):
+            name = ' '.join(match.group('name').split())
+            if name:
+                officers.setdefault(self._canonical_office(match.group('office')), name)
+
+        return officers
+
+    def is_adjournment_sine_die(self, text: str) -> bool:
+        """
+        Check whether a sitting ended with an adjournment sine die.
+
+        Adjournment sine die (without a fixed date to resume) marks the last
+        sitting before a recess or the end of a session.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            True if the text records an adjournment sine die
+        """
+        return bool(text) and bool(self.SINE_DIE_PATTERN.search(text))
+
+    def detect_adjournment(self, text: str) -> Optional[str]:
+        """
+        Detect how a sitting was adjourned.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            ADJOURNMENT_SINE_DIE, ADJOURNMENT_ORDINARY, or None if no
+            adjournment is recorded
+        """
+        if not text:
+            return None
+
+        if self.is_adjournment_sine_die(text):
+            return ADJOURNMENT_SINE_DIE
+
+        if self.ADJOURNMENT_PATTERN.search(text):
+            return ADJOURNMENT_ORDINARY
+
+        logger.debug("No adjournment found in text")
+        return None
+
+    def count_speaker_interventions(self, text: str) -> int:
+        """
+        Count the Chair's calls to order during a sitting.
+
+        Each "Order!" or "Hon. Members!" in a statement by the presiding
+        officer is counted, so "Order! Order!" counts twice; the same words
+        from a Member are not. Frequent calls indicate a disorderly sitting.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            Number of calls to order
+        """
+        if not text:
+            return 0
+
+        speakers = self.identifier.find_all_speakers(text)
+
+        count = 0
+        for i, (label, _, label_end) in enumerate(speakers):
+            if not self.PRESIDING_OFFICER_PATTERN.search(' '.join(label.split())):
+                continue
+            end = speakers[i + 1][1] if i + 1 < len(speakers) else len(text)
+            count += len(self.ORDER_CALL_PATTERN.findall(text, label_end, end))
+
+        return count
+
+    def detect_vote_outcome(self, text: str) -> Optional[VoteOutcome]:
+        """
+        Detect how a Question was decided and whether votes were recorded.
+
+        Most Questions are decided on a voice vote ("the Ayes have it"),
+        which records no individual votes. A division records each MP's
+        vote and prints the totals; when totals are present they decide the
+        result.
+
+        Args:
+            text: Text of the decision on a single Question
+
+        Returns:
+            VoteOutcome with the result (VOTE_AGREED, VOTE_NEGATIVED, or
+            None if the division totals are missing and no result is
+            announced) and whether it was a recorded division, or None if
+            the text records no vote
+        """
+        if not text:
+            return None
+
+        ayes = self.AYES_COUNT_PATTERN.search(text)
+        noes = self.NOES_COUNT_PATTERN.search(text)
+        recorded = bool(self.DIVISION_PATTERN.search(text) or (ayes and noes))
+
+        if ayes and noes:
+            ayes_count, noes_count = int(ayes.group(1)), int(noes.group(1))
+            return VoteOutcome(
+                result=VOTE_AGREED if ayes_count > noes_count else VOTE_NEGATIVED,
+                recorded=True,
+                ayes=ayes_count,
+                noes=noes_count
+            )
+
+        result = None
+        question_put = self.QUESTION_PUT_PATTERN.search(text)
+        have_it = self.HAVE_IT_PATTERN.search(text)
+        if question_put:
+            result = VOTE_NEGATIVED if question_put.group(1).lower() == 'negatived' else VOTE_AGREED
+        elif have_it:
+            result = VOTE_AGREED if have_it.group(1).lower() == 'ayes' else VOTE_NEGATIVED
+
+        if result is None and not recorded:
+            logger.debug("No vote found in text")
+            return None
+
+        return VoteOutcome(result=result, recorded=recorded)
+
+    def detect_closure_motions(self, text: str) -> List[ClosureEvent]:
+        """
+        Find closure motions, where debate was cut short by moving that the
+        Question be now put or that the Mover be called upon to reply.
+
+        Each closure is paired with the first decision on a closure recorded
+        after it and before the next closure motion. A decision recorded
+        without the motion itself is reported at the decision.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of ClosureEvent objects in order, with the offset of the
+            motion and whether it carried (None if no decision is recorded)
+        """
+        if not text:
+            return []
+
+        decisions = list(self.CLOSURE_DECISION_PATTERN.finditer(text))
+        motions = [
+            match.start() for match in self.CLOSURE_MOTION_PATTERN.finditer(text)
+            if not any(decision.start() <= match.start() < decision.end() for decision in decisions)
+        ]
+
+        events = []
+        previous_end = 0
+        for decision in decisions:
+            carried = decision.group(1).lower() != 'negatived'
+            pending = [position for position in motions if previous_end <= position < decision.start()]
+
+            # Earlier undecided motions were not pressed to a decision
+            events.extend(ClosureEvent(position=position, carried=None) for position in pending[:-1])
+            events.append(ClosureEvent(position=pending[-1] if pending else decision.start(), carried=carried))
+            previous_end = decision.end()
+
+        events.extend(ClosureEvent(position=position, carried=None) for position in motions if position >= previous_end)
+
+        logger.debug(f"Found {len(events)} closure motions")
+        return events
+
+    def detect_prayer_type(self, text: str) -> str:
+        """
+        Detect which prayers opened a sitting.
+
+        The National Assembly opened with Christian prayers until multi-faith
+        prayers were introduced in 2023. Only the first PRAYER_SEARCH_CHARS
+        characters are searched, so later mentions in debate are ignored.
+
+        Args:
+            text: Hansard text (the first page is sufficient)
+
+        Returns:
+            PRAYER_MULTI_FAITH if the prayers are marked as multi-faith,
+            PRAYER_CHRISTIAN for an unqualified PRAYERS heading, or
+            PRAYER_UNKNOWN if no prayers are recorded
+        """
+        if not text:
+            return PRAYER_UNKNOWN
+
+        opening = text[:self.PRAYER_SEARCH_CHARS]
+
+        if self.MULTI_FAITH_PATTERN.search(opening):
+            return PRAYER_MULTI_FAITH
+
+        if self.PRAYERS_PATTERN.search(opening):
+            return PRAYER_CHRISTIAN
+
+        logger.debug("No prayers found in opening text")
+        return PRAYER_UNKNOWN
+
+    def parse_chair_changes(self, text: str) -> List[ChairEvent]:
+        """
+        Extract the bracketed annotations recording who took the Chair.
+
+        Combined with statement offsets, the last event before a statement
+        gives the presiding officer at the time it was made.
+
+        Args:
+            text: Hansard text
+
+        Returns:
+            List of ChairEvent objects in document order. The name is empty
+            when the annotation gives only the role.
+        """
+        if not text:
+            return []
+
+        events = []
+        for match in self.CHAIR_PATTERN.finditer(text):
+            name = match.group('name')
+            events.append(ChairEvent(
+                role=' '.join(match.group('role').split()).title(),
+                name=self.identifier.title_case_name(self.identifier.normalize_mp_name(name)) if name else '',
+                position=match.start()
+            ))
+
+        return events
@@ -21,6 +21,8 @@ from urllib.parse import urljoin, urlparse
 import requests
 from bs4 import BeautifulSoup
 
+from hansard_tales.dates import InvalidDateError, extract_date
+
 
 # Configure logging
 logging.basicConfig(
@@ -129,7 +131,14 @@ class HansardScraper:
                     title = parent.get_text(strip=True)
             
             # Try to extract date from title or URL
-            date = self.extract_date(title) or self.extract_date(href)
+            date = None
+            for source in (title, href):
+                try:
+                    date = self.extract_date(source)
+                except InvalidDateError as e:
+                    logger.warning(f"Ignoring {e} in {source!r}")
+                if date:
+                    break
             
             hansard_items.append({
                 'url': pdf_url,
@@ -149,36 +158,12 @@ class HansardScraper:
             
         Returns:
             Date string in YYYY-MM-DD format or None
+            
+        Raises:
+            InvalidDateError: If the text contains a date-like string that is
+                not a real date (e.g., "2025-13-45")
         """
-        # Pattern: DD/MM/YYYY or DD-MM-YYYY
-        pattern1 = r'(\d{1,2})[/-](\d{1,2})[/-](\d{4})'
-        match = re.search(pattern1, text)
-        if match:
-            day, month, year = match.groups()
-            return f"{year}-{month.zfill(2)}-{day.zfill(2)}"
-        
-        # Pattern: YYYY-MM-DD
-        pattern2 = r'(\d{4})-(\d{1,2})-(\d{1,2})'
-        match = re.search(pattern2, text)
-        if match:
-            year, month, day = match.groups()
-            return f"{year}-{month.zfill(2)}-{day.zfill(2)}"
-        
-        # Pattern: Month DD, YYYY
-        pattern3 = r'(January|February|March|April|May|June|July|August|September|October|November|December)\s+(\d{1,2}),?\s+(\d{4})'
-        match = re.search(pattern3, text, re.IGNORECASE)
-        if match:
-            month_name, day, year = match.groups()
-            month_map = {
-                'january': '01', 'february': '02', 'march': '03',
-                'april': '04', 'may': '05', 'june': '06',
-                'july': '07', 'august': '08', 'september': '09',
-                'october': '10', 'november': '11', 'december': '12'
-            }
-            month = month_map.get(month_name.lower(), '01')
-            return f"{year}-{month}-{day.zfill(2)}"
-        
-        return None
+        return extract_date(text)
     
     def download_pdf(self, url: str, filename: str) -> bool:
         """
@@ -22,6 +22,8 @@ from urllib.parse import urljoin
 import requests
 from bs4 import BeautifulSoup
 
+from hansard_tales.exporters import write_csv
+
 # Configure logging
 logging.basicConfig(
     level=logging.INFO,
@@ -269,8 +271,6 @@ class MPDataScraper:
             mps: List of MP dictionaries
             output_path: Path to output CSV file
         """
-        import csv
-        
         output_file = Path(output_path)
         output_file.parent.mkdir(parents=True, exist_ok=True)
         
@@ -282,9 +282,7 @@ class MPDataScraper:
                      'photo_url', 'term_start_year']
         
         with open(output_file, 'w', encoding='utf-8', newline='') as f:
-            writer = csv.DictWriter(f, fieldnames=fieldnames)
-            writer.writeheader()
-            writer.writerows(mps)
+            write_csv(f, fieldnames, mps)
         
         logger.info(f"Saved {len(mps)} MPs to {output_path}")
 
@@ -0,0 +1,372 @@
+#!/usr/bin/env python3
+"""
+Utilities for working with Hansard session records.
+
+Session records are dictionaries as produced by HansardScraper or read from
+the hansard_sessions table, with keys such as 'date' (YYYY-MM-DD), 'title',
+'url' or 'pdf_url', and optionally 'house'. Some sources give 'date' as a
+timestamp instead; sitting_day reduces either form to the calendar day in
+Kenya.
+
+Usage:
+    from hansard_tales.sessions import dedupe_sessions
+
+    sessions = dedupe_sessions(scraper.scrape_all() + mirror_sessions)
+    stored = compress_transcript(transcript)
+    summary = summarize_session(transcript)
+    sittings = split_compiled_hansard(weekly_volume_text)
+    recent = rolling_sitting_count(sessions, timedelta(days=30), at=date.today())
+"""
+import gzip
+import hashlib
+import re
+import zlib
+from collections import defaultdict
+from dataclasses import dataclass
+from datetime import date, datetime, timedelta
+from typing import Dict, List, Optional, Union
+from zoneinfo import ZoneInfo
+
+from hansard_tales.analysis.speech_metrics import count_words
+from hansard_tales.dates import MONTHS, WEEKDAYS, extract_date, parse_textual_date
+from hansard_tales.processors.bill_extractor import BillExtractor
+from hansard_tales.processors.mp_identifier import MPIdentifier
+from hansard_tales.processors.section_parser import SectionParser
+from hansard_tales.processors.sitting_parser import SittingParser
+
+
+# Sittings are dated in East Africa Time
+SITTING_TIMEZONE = ZoneInfo('Africa/Nairobi')
+
+# Default window for rolling_sitting_count
+DEFAULT_ROLLING_WINDOW = timedelta(days=30)
+
+# First two bytes of every gzip stream
+GZIP_MAGIC = b'\x1f\x8b'
+
+# Header opening each sitting in a compiled volume, e.g.
+# "NATIONAL ASSEMBLY\nOFFICIAL REPORT\nTuesday, 13th February, 2024"
+SITTING_HEADER_PATTERN = re.compile(
+    r'^(?:[ \t]*(?:NATIONAL\s+ASSEMBLY|THE\s+SENATE|OFFICIAL\s+REPORT)[ \t]*\n\s*)*'
+    rf'[ \t]*(?:{"|".join(WEEKDAYS)})[ \t]*,?[ \t]+\d{{1,2}}(?:st|nd|rd|th)?[ \t]+'
+    rf'(?:{"|".join(MONTHS)})[ \t]*,?[ \t]+\d{{4}}[ \t]*$',
+    re.IGNORECASE | re.MULTILINE
+)
+
+
+@dataclass
+class SessionSummary:
+    """At-a-glance counts for one sitting."""
+    date: Optional[str] = None
+    speaker_count: int = 0
+    statement_count: int = 0
+    word_count: int = 0
+    bills_mentioned: int = 0
+    question_count: int = 0
+    order_calls: int = 0
+
+
+def normalize_session_title(title: str) -> str:
+    """
+    Normalize a session title for comparison.
+
+    Args:
+        title: Raw session title
+
+    Returns:
+        Lowercase title with punctuation removed and whitespace collapsed
+    """
+    if not title:
+        return ''
+
+    title = re.sub(r'[^\w\s]', ' ', title.lower())
+    return ' '.join(title.split())
+
+
+def session_fingerprint(session: Dict) -> str:
+    """
+    Compute a fingerprint identifying the sitting a record describes.
+
+    The fingerprint covers the date, house and normalized title only, so
+    the same sitting downloaded from different mirrors (different URLs or
+    filenames) produces the same fingerprint.
+
+    Args:
+        session: Session dictionary
+
+    Returns:
+        Hex SHA-256 digest
+    """
+    parts = [
+        (session.get('date') or '').strip(),
+        (session.get('house') or '').strip().lower(),
+        normalize_session_title(session.get('title') or ''),
+    ]
+    return hashlib.sha256('\x1f'.join(parts).encode('utf-8')).hexdigest()
+
+
+def _populated_fields(session: Dict) -> int:
+    """Count fields that hold a non-empty value."""
+    return sum(1 for value in session.values() if value not in (None, ''))
+
+
+def dedupe_sessions(sessions: List[Dict]) -> List[Dict]:
+    """
+    Remove duplicate records of the same sitting.
+
+    Records sharing a fingerprint are collapsed to the one with the most
+    populated fields (the earliest wins a tie). The result keeps the order
+    in which each sitting was first seen.
+
+    Args:
+        sessions: Session dictionaries, possibly from several sources
+
+    Returns:
+        Deduplicated list of session dictionaries
+    """
+    best: Dict[str, Dict] = {}
+    order: List[str] = []
+
+    for session in sessions:
+        key = session_fingerprint(session)
+
+        if key not in best:
+            best[key] = session
+            order.append(key)
+        elif _populated_fields(session) > _populated_fields(best[key]):
+            best[key] = session
+
+    return [best[key] for key in order]
+
+
+def sitting_day(session: Dict) -> str:
+    """
+    Get the calendar day of a sitting in Kenyan time.
+
+    Timezone-aware timestamps (e.g. "2024-03-05T18:30:00Z") are converted
+    to Africa/Nairobi before taking the date, so an evening sitting stored
+    in UTC is not moved to the wrong day. Plain dates and naive timestamps
+    are taken to be local already.
+
+    Args:
+        session: Session dictionary whose 'date' is a YYYY-MM-DD string, an
+            ISO 8601 timestamp, or a date/datetime
+
+    Returns:
+        Date string in YYYY-MM-DD format
+
+    Raises:
+        ValueError: If the session has no parseable date
+    """
+    value = session.get('date')
+
+    if isinstance(value, str):
+        value = datetime.fromisoformat(value.strip())
+    elif not isinstance(value, date):
+        raise ValueError(f"Session has no date: {value!r}")
+
+    if isinstance(value, datetime):
+        if value.tzinfo is not None:
+            value = value.astimezone(SITTING_TIMEZONE)
+        value = value.date()
+
+    return value.isoformat()
+
+
+def group_sessions_by_day(sessions: List[Dict]) -> Dict[str, List[Dict]]:
+    """
+    Group sessions by their sitting day.
+
+    Sessions without a valid date (such as scraped sessions whose title
+    gave none) are skipped, as in rolling_sitting_count.
+
+    Args:
+        sessions: Session dictionaries
+
+    Returns:
+        Mapping of YYYY-MM-DD to the sessions held that day, in input order
+    """
+    groups: Dict[str, List[Dict]] = defaultdict(list)
+    for session in sessions:
+        try:
+            day = sitting_day(session)
+        except ValueError:
+            continue
+        groups[day].append(session)
+    return dict(groups)
+
+
+def rolling_sitting_count(
+    sessions: List[Dict],
+    window: timedelta = DEFAULT_ROLLING_WINDOW,
+    at: Optional[Union[date, datetime]] = None
+) -> int:
+    """
+    Count the sittings held in the window of days up to a reference date.
+
+    Sittings are compared by their sitting_day, and the window includes
+    both ends: [at - window, at]. A timezone-aware at is converted to
+    SITTING_TIMEZONE first, as sitting days are. Sessions without a valid
+    date are skipped.
+
+    Args:
+        sessions: Session dictionaries
+        window: Length of the window (default: 30 days)
+        at: End of the window (default: today)
+
+    Returns:
+        Number of sessions whose sitting day falls in the window
+    """
+    if isinstance(at, datetime):
+        if at.tzinfo is not None:
+            at = at.astimezone(SITTING_TIMEZONE)
+        end = at.date()
+    else:
+        end = at or date.today()
+    start = end - window
+
+    count = 0
+    for session in sessions:
+        try:
+            day = date.fromisoformat(sitting_day(session))
+        except ValueError:
+            continue
+        if start <= day <= end:
+            count += 1
+
+    return count
+
+
+def group_sessions_by_month(sessions: List[Dict]) -> Dict[str, List[Dict]]:
+    """
+    Group sessions by the month of their sitting day.
+
+    Sessions without a valid date (such as scraped sessions whose title
+    gave none) are skipped, as in rolling_sitting_count.
+
+    Args:
+        sessions: Session dictionaries
+
+    Returns:
+        Mapping of YYYY-MM to the sessions held that month, in input order
+    """
+    groups: Dict[str, List[Dict]] = defaultdict(list)
+    for session in sessions:
+        try:
+            day = sitting_day(session)
+        except ValueError:
+            continue
+        groups[day[:7]].append(session)
+    return dict(groups)
+
+
+def compress_transcript(text: str) -> bytes:
+    """
+    Compress a sitting transcript for storage.
+
+    Args:
+        text: Transcript text
+
+    Returns:
+        gzip-compressed UTF-8 bytes
+    """
+    return gzip.compress(text.encode('utf-8'))
+
+
+def decompress_transcript(data: bytes) -> str:
+    """
+    Restore a transcript stored with compress_transcript.
+
+    Args:
+        data: gzip-compressed transcript
+
+    Returns:
+        Transcript text
+
+    Raises:
+        ValueError: If data is not a gzip stream or is corrupt
+    """
+    if not data.startswith(GZIP_MAGIC):
+        raise ValueError("Transcript data is not gzip-compressed")
+
+    try:
+        return gzip.decompress(data).decode('utf-8')
+    except (OSError, EOFError, zlib.error, UnicodeDecodeError) as e:
+        raise ValueError(f"Corrupt transcript data: {e}") from e
+
+
+def summarize_session(text: str) -> SessionSummary:
+    """
+    Summarize a sitting's transcript using the existing parsers.
+
+    The date is taken from the sitting header (see
+    SittingParser.extract_sitting_date), falling back to the first date in
+    the text if there is no header. Speakers and statements count MPs only,
+    as extracted by MPIdentifier; words are spoken words, excluding
+    annotations. Bills are counted once each however often they are
+    mentioned, questions are the numbered questions found by SectionParser,
+    and order calls are the Chair's "Order!" interjections.
+
+    Args:
+        text: Full transcript text
+
+    Returns:
+        SessionSummary for the sitting; the date is None if the text has
+        none
+
+    Raises:
+        InvalidDateError: If the header's date, or without a header the
+            only dates in the text, are not real dates
+    """
+    if not text:
+        return SessionSummary()
+
+    statements = MPIdentifier().extract_statements(text)
+    sitting_parser = SittingParser()
+
+    return SessionSummary(
+        date=sitting_parser.extract_sitting_date(text) or extract_date(text),
+        speaker_count=len({statement.mp_name for statement in statements}),
+        statement_count=len(statements),
+        word_count=sum(count_words(statement.text) for statement in statements),
+        bills_mentioned=len(BillExtractor().extract_bill_references(text)),
+        question_count=len(SectionParser().parse_questions(text)),
+        order_calls=sitting_parser.count_speaker_interventions(text)
+    )
+
+
+def split_compiled_hansard(text: str) -> List[str]:
+    """
+    Split a volume covering several sittings into one transcript per sitting.
+
+    Each sitting starts at a header line giving its weekday and date,
+    together with any "NATIONAL ASSEMBLY" / "OFFICIAL REPORT" lines just
+    above it. A repeated header for the same date (a running page header)
+    does not start a new sitting, and any cover pages before the first
+    header stay with the first sitting.
+
+    Args:
+        text: Text of the compiled volume
+
+    Returns:
+        Sitting transcripts in the order they appear
+
+    Raises:
+        ValueError: If the text has no sitting header
+        InvalidDateError: If a header's date is not a real date
+    """
+    starts = []
+    last_date = None
+    for match in SITTING_HEADER_PATTERN.finditer(text or ''):
+        sitting_date = parse_textual_date(match.group(0))
+        if sitting_date != last_date:
+            starts.append(match.start())
+            last_date = sitting_date
+
+    if not starts:
+        raise ValueError("No sitting header found in compiled Hansard")
+
+    starts[0] = 0
+    ends = starts[1:] + [len(text)]
+
+    return [text[start:end].strip() for start, end in zip(starts, ends)]
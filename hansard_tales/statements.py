@@ -0,0 +1,423 @@
+#!/usr/bin/env python3
+"""
+Utilities for working with extracted MP statements.
+
+Statements are the Statement objects produced by MPIdentifier.
+
+Usage:
+    from hansard_tales.statements import sample_statements, speaker_sequence
+
+    qa_batch = sample_statements(statements, 50, seed=2024)
+    turns = speaker_sequence(statements, collapse_consecutive=True)
+    repeats = find_duplicate_statements_across_sessions(statements_by_session)
+    debates = group_into_debates(statements, SectionParser().heading_positions(text))
+    maiden = first_statement_per_mp(sessions, statements_by_session)
+    comparison = compare_statement_parses(baseline_statements, new_statements)
+    timed = align_statements_to_chapters(statements, chapters)
+    paragraphs = statement_paragraphs(statement)
+    quality = quality_score(substantive_statements(statements))
+    kept, short = split_short_statements(statements, min_words=3)
+"""
+import bisect
+import hashlib
+import random
+import re
+from dataclasses import dataclass, field
+from datetime import timedelta
+from typing import Dict, List, Tuple
+
+from hansard_tales.analysis.speech_metrics import count_words, extract_editorial_notes, strip_annotations
+from hansard_tales.processors.bill_extractor import BillExtractor
+from hansard_tales.processors.mp_identifier import STATEMENT_SUBSTANTIVE, Statement
+
+# One or more blank (or whitespace-only) lines between paragraphs
+PARAGRAPH_BREAK_PATTERN = re.compile(r'\n[ \t]*\n\s*')
+
+# A motion as moved: "THAT, this House adopts the Report ...", up to the
+# full stop ending it (not the one in "Hon." or "No.")
+MOTION_PATTERN = re.compile(
+    r'\bTHAT\b,?\s+(?:this|the)\s+House\b.*?(?:(?<!\bHon)(?<!\bNo)(?<!\bMr)(?<!\bDr)\.(?=\s|$)|$)',
+    re.DOTALL
+)
+
+
+@dataclass
+class StatementRef:
+    """Locates a statement within the statements of a session."""
+    session_id: object
+    index: int
+
+
+@dataclass
+class Debate:
+    """Represents consecutive statements on one item of business."""
+    start: int
+    statements: List[Statement] = field(default_factory=list)
+    bill_reference: str = ""
+    motion: str = ""
+
+
+@dataclass
+class Chapter:
+    """A chapter of a sitting's video recording covering one agenda item.
+
+    position is the text offset where the agenda item starts in the
+    transcript, as from SectionParser.heading_positions.
+    """
+    title: str
+    start: timedelta
+    end: timedelta
+    position: int
+
+
+@dataclass
+class TimedStatement:
+    """A statement with its approximate time span in the video."""
+    statement: Statement
+    start: timedelta
+    end: timedelta
+
+
+@dataclass
+class ParseComparison:
+    """Agreement between two extractions of the same document."""
+    matched: int = 0
+    only_in_a: List[Statement] = field(default_factory=list)
+    only_in_b: List[Statement] = field(default_factory=list)
+
+    @property
+    def agreement(self) -> float:
+        """Share of all statements that were matched (1.0 when both are empty)."""
+        total = 2 * self.matched + len(self.only_in_a) + len(self.only_in_b)
+        return 2 * self.matched / total if total else 1.0
+
+
+def sample_statements(statements: List[Statement], n: int, seed: int) -> List[Statement]:
+    """
+    Choose a reproducible random sample of statements.
+
+    The same input and seed always give the same sample. Sampled statements
+    keep their original relative order. If n is at least the number of
+    statements, all of them are returned.
+
+    Args:
+        statements: Statements to sample from
+        n: Number of statements to choose
+        seed: Random seed
+
+    Returns:
+        New list of sampled statements
+    """
+    if n <= 0:
+        return []
+
+    if n >= len(statements):
+        return list(statements)
+
+    indices = random.Random(seed).sample(range(len(statements)), n)
+    return [statements[i] for i in sorted(indices)]
+
+
+def substantive_statements(statements: List[Statement]) -> List[Statement]:
+    """
+    Keep only substantive contributions to debate.
+
+    Points of order and procedural business such as "I beg to second." are
+    dropped, so word-count and quality metrics reflect debate alone.
+
+    Args:
+        statements: Statements classified by MPIdentifier
+
+    Returns:
+        Statements of kind STATEMENT_SUBSTANTIVE, in input order
+    """
+    return [statement for statement in statements if statement.kind == STATEMENT_SUBSTANTIVE]
+
+
+def split_short_statements(
+    statements: List[Statement],
+    min_words: int
+) -> Tuple[List[Statement], List[Statement]]:
+    """
+    Separate statements too short to analyse, such as "Thank you.".
+
+    Words are counted with count_words, so annotations like "(Applause)" do
+    not lift a statement over the threshold.
+
+    Args:
+        statements: Statements to split
+        min_words: Minimum number of spoken words to keep a statement
+            (0 keeps everything)
+
+    Returns:
+        Tuple of (statements with at least min_words words, shorter
+        statements), each in input order
+    """
+    kept = []
+    short = []
+
+    for statement in statements:
+        if count_words(statement.text) < min_words:
+            short.append(statement)
+        else:
+            kept.append(statement)
+
+    return kept, short
+
+
+def speaker_sequence(statements: List[Statement], collapse_consecutive: bool = False) -> List[str]:
+    """
+    List speakers in the order they spoke.
+
+    Args:
+        statements: Statements from one sitting, in document order
+        collapse_consecutive: Whether to merge consecutive statements by the
+            same speaker (for example across a page break) into one turn
+
+    Returns:
+        Speaker names, one per turn
+    """
+    sequence: List[str] = []
+
+    for statement in statements:
+        if collapse_consecutive and sequence and sequence[-1] == statement.mp_name:
+            continue
+        sequence.append(statement.mp_name)
+
+    return sequence
+
+
+def statement_paragraphs(statement: Statement) -> List[str]:
+    """
+    Split a statement's text into paragraphs.
+
+    Editorial notes such as "(Applause)" are removed first, then the text is
+    split on blank lines. Lines wrapped within a paragraph are joined and
+    paragraphs left empty are dropped.
+
+    Args:
+        statement: Statement to split
+
+    Returns:
+        List of paragraphs in order
+    """
+    cleaned, _ = extract_editorial_notes(statement.text)
+    paragraphs = (' '.join(block.split()) for block in PARAGRAPH_BREAK_PATTERN.split(cleaned))
+    return [paragraph for paragraph in paragraphs if paragraph]
+
+
+def statement_content_hash(statement: Statement) -> str:
+    """
+    Hash a statement's spoken content.
+
+    Annotations such as "(Applause)" are removed and whitespace is
+    normalized first, so the same words hash alike regardless of layout.
+    The speaker is not part of the hash.
+
+    Args:
+        statement: Statement to hash
+
+    Returns:
+        Hex-encoded SHA-256 digest
+    """
+    content = ' '.join(strip_annotations(statement.text).split())
+    return hashlib.sha256(content.encode('utf-8')).hexdigest()
+
+
+def find_duplicate_statements_across_sessions(
+    statements_by_session: Dict[str, List[Statement]]
+) -> Dict[str, List[str]]:
+    """
+    Find statement content that recurs in more than one sitting.
+
+    Repeats within a single sitting are not reported. Statements with no
+    spoken content are ignored.
+
+    Args:
+        statements_by_session: Mapping of session key to its statements
+
+    Returns:
+        Mapping of content hash to the keys of the sessions containing it,
+        in input order, for content found in at least two sessions
+    """
+    sessions_by_hash: Dict[str, List[str]] = {}
+
+    for session_key, statements in statements_by_session.items():
+        for statement in statements:
+            if not strip_annotations(statement.text).strip():
+                continue
+
+            sessions = sessions_by_hash.setdefault(statement_content_hash(statement), [])
+            if session_key not in sessions:
+                sessions.append(session_key)
+
+    return {
+        content_hash: sessions
+        for content_hash, sessions in sessions_by_hash.items()
+        if len(sessions) > 1
+    }
+
+
+def group_into_debates(statements: List[Statement], boundaries: List[int]) -> List[Debate]:
+    """
+    Group a sitting's statements into debates on each item of business.
+
+    Boundaries are text offsets where a new item of business starts, such
+    as those from SectionParser.heading_positions. Statements are assigned
+    by their start_position, so they must index into the same text.
+    Stretches without statements produce no debate. The bill reference is
+    the first Bill mentioned in the debate, and the motion the first
+    "THAT, this House ..." moved in it, if any.
+
+    Args:
+        statements: Statements from one sitting
+        boundaries: Offsets where items of business begin
+
+    Returns:
+        List of Debate objects in document order; a debate's start is its
+        boundary offset (0 for statements before the first boundary)
+    """
+    starts = sorted(set(boundaries))
+    debates: Dict[int, Debate] = {}
+
+    for statement in sorted(statements, key=lambda s: s.start_position):
+        index = bisect.bisect_right(starts, statement.start_position)
+        start = starts[index - 1] if index else 0
+        debates.setdefault(start, Debate(start=start)).statements.append(statement)
+
+    extractor = BillExtractor()
+    for debate in debates.values():
+        for statement in debate.statements:
+            bills = extractor.extract_bill_references(statement.text)
+            if bills:
+                debate.bill_reference = extractor.format_bill_reference(bills[0])
+                break
+
+        for statement in debate.statements:
+            motion = MOTION_PATTERN.search(statement.text)
+            if motion:
+                debate.motion = ' '.join(motion.group(0).split())
+                break
+
+    return list(debates.values())
+
+
+def first_statement_per_mp(
+    sessions: List[Dict],
+    statements_by_session: Dict[object, List[Statement]]
+) -> Dict[str, StatementRef]:
+    """
+    Locate each MP's first statement, such as a maiden speech.
+
+    Sessions must already be sorted by date; statements within a session
+    are taken in list order.
+
+    Args:
+        sessions: Session dictionaries with an 'id', oldest first
+        statements_by_session: Mapping of session id to its statements
+
+    Returns:
+        Mapping of MP name to the reference of their earliest statement
+    """
+    first: Dict[str, StatementRef] = {}
+
+    for session in sessions:
+        session_id = session.get('id')
+        for index, statement in enumerate(statements_by_session.get(session_id, [])):
+            if statement.mp_name not in first:
+                first[statement.mp_name] = StatementRef(session_id=session_id, index=index)
+
+    return first
+
+
+def compare_statement_parses(a: List[Statement], b: List[Statement]) -> ParseComparison:
+    """
+    Compare two extractions of the same document, e.g. by two parser versions.
+
+    A statement in a matches a statement in b when both have the same
+    speaker and page_number and their [start_position, end_position) spans
+    overlap; positions are relative to the page, so spans on different
+    pages are never compared. Each statement is matched at most once,
+    preferring the largest overlap.
+
+    Args:
+        a: Statements from the first (baseline) parse
+        b: Statements from the second parse
+
+    Returns:
+        ParseComparison with the number of matches and the statements found
+        by only one parse, in their original order
+    """
+    unmatched_b = list(range(len(b)))
+    comparison = ParseComparison()
+
+    for statement in a:
+        best, best_overlap = None, 0
+        for i in unmatched_b:
+            other = b[i]
+            if (other.mp_name != statement.mp_name
+                    or other.page_number != statement.page_number):
+                continue
+
+            overlap = (min(statement.end_position, other.end_position)
+                       - max(statement.start_position, other.start_position))
+            if overlap > best_overlap:
+                best, best_overlap = i, overlap
+
+        if best is None:
+            comparison.only_in_a.append(statement)
+        else:
+            unmatched_b.remove(best)
+            comparison.matched += 1
+
+    comparison.only_in_b = [b[i] for i in unmatched_b]
+    return comparison
+
+
+def align_statements_to_chapters(
+    statements: List[Statement],
+    chapters: List[Chapter]
+) -> List[TimedStatement]:
+    """
+    Estimate when each statement was made from a video's chapter markers.
+
+    Statements are assigned to the chapter whose agenda item they fall
+    under (by start_position), and each chapter's time span is shared out
+    among its statements in proportion to their word counts (equally if
+    none has words). The result is approximate but close enough to jump to
+    a statement in the video.
+
+    Args:
+        statements: Statements from one sitting
+        chapters: Chapters of the sitting's recording
+
+    Returns:
+        List of TimedStatement objects in document order; statements before
+        the first chapter are omitted
+    """
+    chapters = sorted(chapters, key=lambda chapter: chapter.position)
+    positions = [chapter.position for chapter in chapters]
+    by_chapter: Dict[int, List[Statement]] = {}
+
+    for statement in sorted(statements, key=lambda s: s.start_position):
+        index = bisect.bisect_right(positions, statement.start_position) - 1
+        if index >= 0:
+            by_chapter.setdefault(index, []).append(statement)
+
+    timed = []
+    for index, members in sorted(by_chapter.items()):
+        chapter = chapters[index]
+        weights = [count_words(statement.text) for statement in members]
+        if not any(weights):
+            weights = [1] * len(members)
+        total = sum(weights)
+        duration = chapter.end - chapter.start
+
+        elapsed = 0
+        for statement, weight in zip(members, weights):
+            start = chapter.start + duration * (elapsed / total)
+            elapsed += weight
+            end = chapter.start + duration * (elapsed / total)
+            timed.append(TimedStatement(statement=statement, start=start, end=end))
+
+    return timed
@@ -0,0 +1,322 @@
+#!/usr/bin/env python3
+"""
+Validation of records before they are written to the database.
+
+Validators raise ValidationError listing every problem found in a record, so
+bad scraper output is caught at ingest rather than surfacing later as broken
+pages. Lenient mode checks only what the database requires; strict mode adds
+sanity checks on the data itself.
+
+Usage:
+    from hansard_tales.validation import ValidationError, validate_hansard_session
+
+    try:
+        validate_hansard_session(session, strict=True)
+    except ValidationError as e:
+        logger.warning(f"Skipping session: {e}")
+"""
+import re
+from datetime import date, datetime
+from typing import Dict, List, Optional, Union
+from urllib.parse import urlparse
+
+from hansard_tales.analysis.performance import PerformanceBreakdown
+from hansard_tales.mps import MEMBERSHIP_STATUSES, membership_status
+from hansard_tales.numeric import ROUNDING_TOLERANCE, float_equal
+
+
+# One "@", no whitespace, and a dot in the domain
+EMAIL_PATTERN = re.compile(r'^[^@\s]+@[^@\s.]+(?:\.[^@\s.]+)+$')
+
+# Characters allowed between the digits of a written phone number
+PHONE_SEPARATORS = re.compile(r'[\s().\-]')
+
+KENYA_COUNTRY_CODE = '254'
+
+# Digits in a Kenyan number after the country code or leading 0
+KENYA_NATIONAL_DIGITS = 9
+
+
+class ValidationError(ValueError):
+    """Raised when a record fails validation."""
+
+    def __init__(self, problems: List[str]):
+        """
+        Initialize the error.
+
+        Args:
+            problems: Human-readable descriptions of each problem found
+        """
+        self.problems = problems
+        super().__init__('; '.join(problems))
+
+
+def _as_date(value: Union[date, datetime]) -> date:
+    """Reduce a datetime to its date, leaving dates unchanged."""
+    return value.date() if isinstance(value, datetime) else value
+
+
+def _url_problems(raw: Optional[str], field: str, required: bool) -> List[str]:
+    """List the problems with a URL field value."""
+    if not raw or not raw.strip():
+        return [f"{field} is required"] if required else []
+
+    try:
+        parsed = urlparse(raw.strip())
+    except ValueError:
+        return [f"{field} {raw!r} is not a valid URL"]
+
+    if parsed.scheme not in ('http', 'https'):
+        return [f"{field} {raw!r} must use http or https"]
+
+    if not parsed.netloc:
+        return [f"{field} {raw!r} has no host"]
+
+    return []
+
+
+def normalize_phone(raw: str) -> str:
+    """
+    Normalize a Kenyan phone number to E.164 form.
+
+    Accepts the usual written forms, such as "0712 345 678",
+    "+254-712-345678", "254712345678" and "(020) 222 1291".
+
+    Args:
+        raw: Phone number as written
+
+    Returns:
+        Number in the form "+254XXXXXXXXX"
+
+    Raises:
+        ValidationError: If the value is not a Kenyan phone number
+    """
+    number = PHONE_SEPARATORS.sub('', raw or '')
+
+    if number.startswith('+'):
+        number = number[1:]
+        if not number.startswith(KENYA_COUNTRY_CODE):
+            raise ValidationError([f"phone {raw!r} is not a Kenyan number"])
+
+    if number.startswith(KENYA_COUNTRY_CODE):
+        number = number[len(KENYA_COUNTRY_CODE):]
+    elif number.startswith('0'):
+        number = number[1:]
+
+    if not number.isdigit() or len(number) != KENYA_NATIONAL_DIGITS:
+        raise ValidationError([f"phone {raw!r} is not a valid phone number"])
+
+    return f'+{KENYA_COUNTRY_CODE}{number}'
+
+
+def validate_url(raw: Optional[str], required: bool = True, field: str = 'url') -> None:
+    """
+    Validate an absolute http or https URL.
+
+    Args:
+        raw: URL to check
+        required: Whether an empty value is an error
+        field: Field name used in problem messages
+
+    Raises:
+        ValidationError: If the URL is missing (when required), has a scheme
+            other than http/https, or has no host
+    """
+    problems = _url_problems(raw, field, required)
+    if problems:
+        raise ValidationError(problems)
+
+
+def validate_hansard_session(
+    session: Dict,
+    strict: bool = False,
+    now: Optional[Union[date, datetime]] = None
+) -> None:
+    """
+    Validate a Hansard session record.
+
+    Lenient checks: 'date' is a valid YYYY-MM-DD date and a valid http(s)
+    PDF URL is present ('pdf_url' or 'url'). Strict mode additionally requires a title
+    and rejects sitting dates after now, which always indicate a scraping
+    error.
+
+    Args:
+        session: Session dictionary
+        strict: Whether to apply strict checks
+        now: Reference time for the future-date check (default: today)
+
+    Raises:
+        ValidationError: If the session is invalid
+    """
+    problems = []
+
+    sitting_date = None
+    raw_date = session.get('date')
+    if not raw_date:
+        problems.append("date is required")
+    else:
+        try:
+            sitting_date = datetime.strptime(raw_date, '%Y-%m-%d').date()
+        except (TypeError, ValueError):
+            problems.append(f"date {raw_date!r} is not a valid YYYY-MM-DD date")
+
+    problems.extend(_url_problems(session.get('pdf_url') or session.get('url'), 'pdf_url', True))
+
+    if strict:
+        if not (session.get('title') or '').strip():
+            problems.append("title is required")
+
+        today = _as_date(now) if now is not None else date.today()
+        if sitting_date and sitting_date > today:
+            problems.append(f"date {raw_date} is in the future")
+
+    if problems:
+        raise ValidationError(problems)
+
+
+def validate_mp(mp: Dict, strict: bool = False) -> None:
+    """
+    Validate an MP record.
+
+    'name' and 'constituency' are required. 'photo_url', 'email' and
+    'phone' are optional; when present the photo URL must be a valid
+    http(s) URL, the email must look like an address and the phone must
+    be a Kenyan number (see normalize_phone). Strict mode additionally
+    requires a membership status from MEMBERSHIP_STATUSES, given as
+    'membership_status' or implied by an "Elected" or "Nominated" 'status'
+    (see membership_status).
+
+    A valid record's phone is stored back in +254 E.164 form; an invalid
+    record is left unchanged.
+
+    Args:
+        mp: MP dictionary, updated with the normalized phone
+        strict: Whether to apply strict checks
+
+    Raises:
+        ValidationError: If the MP record is invalid
+    """
+    problems = []
+
+    for field in ('name', 'constituency'):
+        if not (mp.get(field) or '').strip():
+            problems.append(f"{field} is required")
+
+    problems.extend(_url_problems(mp.get('photo_url'), 'photo_url', False))
+
+    email = (mp.get('email') or '').strip()
+    if email and not EMAIL_PATTERN.match(email):
+        problems.append(f"email {email!r} is not a valid email address")
+
+    phone = None
+    if (mp.get('phone') or '').strip():
+        try:
+            phone = normalize_phone(mp['phone'])
+        except ValidationError as e:
+            problems.extend(e.problems)
+
+    if strict:
+        status = membership_status(mp)
+        if not status:
+            problems.append("membership_status is required")
+        elif status not in MEMBERSHIP_STATUSES:
+            problems.append(
+                f"membership_status {status!r} is not one of {', '.join(sorted(MEMBERSHIP_STATUSES))}"
+            )
+
+    if problems:
+        raise ValidationError(problems)
+
+    if phone:
+        mp['phone'] = phone
+
+
+def validate_dataset(mps: List[Dict], bills: List[Dict], votes: List[Dict]) -> None:
+    """
+    Check references between MP, bill and vote records.
+
+    Every bill's 'sponsor_mp_id' (when set) and 'co_sponsor_mp_ids', and
+    every vote's 'mp_id', must be the 'id' of a known MP, and every vote's
+    'bill_id' must be the 'id' of a known bill. Dangling references
+    otherwise surface only as silently empty joins.
+
+    Args:
+        mps: MP dictionaries
+        bills: Bill dictionaries
+        votes: Vote dictionaries
+
+    Raises:
+        ValidationError: Listing every dangling reference found
+    """
+    mp_ids = {mp.get('id') for mp in mps}
+    bill_ids = {bill.get('id') for bill in bills}
+    problems = []
+
+    for bill in bills:
+        sponsor = bill.get('sponsor_mp_id')
+        if sponsor is not None and sponsor not in mp_ids:
+            problems.append(f"bill {bill.get('id')!r} sponsor_mp_id {sponsor!r} is not a known MP")
+        for co_sponsor in bill.get('co_sponsor_mp_ids') or []:
+            if co_sponsor not in mp_ids:
+                problems.append(f"bill {bill.get('id')!r} co-sponsor {co_sponsor!r} is not a known MP")
+
+    for i, vote in enumerate(votes):
+        if vote.get('mp_id') not in mp_ids:
+            problems.append(f"vote {i} mp_id {vote.get('mp_id')!r} is not a known MP")
+        if vote.get('bill_id') not in bill_ids:
+            problems.append(f"vote {i} bill_id {vote.get('bill_id')!r} is not a known bill")
+
+    if problems:
+        raise ValidationError(problems)
+
+
+def validate_performance_inputs(attendance: float, bills: float, quality: float) -> None:
+    """
+    Check performance score components before combining them.
+
+    calculate_performance_score clamps out-of-range components; callers
+    that would rather reject bad data can validate first.
+
+    Args:
+        attendance: Attendance score
+        bills: Bills score
+        quality: Quality score
+
+    Raises:
+        ValidationError: Listing every component outside 0-100
+    """
+    problems = [
+        f"{name} {value!r} is outside 0-100"
+        for name, value in (('attendance', attendance), ('bills', bills), ('quality', quality))
+        if not 0 <= value <= 100
+    ]
+
+    if problems:
+        raise ValidationError(problems)
+
+
+def validate_breakdown(breakdown: PerformanceBreakdown) -> None:
+    """
+    Check that a stored performance breakdown is self-consistent.
+
+    The component contributions must sum to the total (within
+    ROUNDING_TOLERANCE) and the total must be within 0-100, which catches
+    corrupted or hand-edited records.
+
+    Args:
+        breakdown: Breakdown to check
+
+    Raises:
+        ValidationError: Listing every inconsistency found
+    """
+    problems = []
+    parts = breakdown.attendance + breakdown.bills + breakdown.quality
+
+    if not float_equal(parts, breakdown.total, ROUNDING_TOLERANCE):
+        problems.append(f"components sum to {parts!r} but total is {breakdown.total!r}")
+
+    if not 0 <= breakdown.total <= 100:
+        problems.append(f"total {breakdown.total!r} is outside 0-100")
+
+    if problems:
+        raise ValidationError(problems)
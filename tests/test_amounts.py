@@ -0,0 +1,51 @@
+"""
+Tests for monetary amount extraction.
+"""
+
+import pytest
+
+from hansard_tales.analysis.amounts import Money, extract_amounts
+
+
+class TestExtractAmounts:
+    """Test suite for shilling amount extraction."""
+
+    @pytest.mark.parametrize("text,value", [
+        ("Kshs 1.2 billion", 1_200_000_000),
+        ("KES 500 million", 500_000_000),
+        ("Sh 20,000", 20_000),
+        ("Kshs.3bn", 3_000_000_000),
+        ("Ksh 2,500,000.50", 2_500_000.5),
+        ("KSh 4 trillion", 4_000_000_000_000),
+        ("Shs 10 thousand", 10_000),
+    ])
+    def test_amount_forms(self, text, value):
+        """Test currency markers, separators and multipliers are handled."""
+        amounts = extract_amounts(text)
+
+        assert len(amounts) == 1
+        assert amounts[0].value == pytest.approx(value)
+        assert amounts[0].raw == text
+
+    def test_multiple_amounts_in_order(self):
+        """Test every amount in a statement is returned with its raw text."""
+        text = "Of the Kshs 10 billion allocated, only Sh 750 million was spent."
+
+        assert extract_amounts(text) == [
+            Money(value=10_000_000_000, raw="Kshs 10 billion"),
+            Money(value=750_000_000, raw="Sh 750 million"),
+        ]
+
+    def test_bare_numbers_ignored(self):
+        """Test numbers without a currency marker are not amounts."""
+        assert extract_amounts("Question No.45 on 20,000 acres in Clause 3") == []
+
+    def test_multiplier_word_must_stand_alone(self):
+        """Test a following word starting with "m" is not a multiplier."""
+        amounts = extract_amounts("Sh 500 more than last year")
+
+        assert amounts == [Money(value=500, raw="Sh 500")]
+
+    def test_empty_text(self):
+        """Test empty text has no amounts."""
+        assert extract_amounts("") == []
@@ -0,0 +1,145 @@
+"""
+Tests for attendance metrics.
+"""
+
+import pytest
+
+from hansard_tales.analysis.attendance import (
+    attendance_by_county,
+    attendance_streaks,
+    cumulative_attendance,
+    rank_counties,
+    silent_mps,
+)
+
+
+@pytest.fixture
+def sample_mps():
+    """Create sample MP records across counties."""
+    return [
+        {'id': 1, 'name': 'A', 'county': 'NAIROBI'},
+        {'id': 2, 'name': 'B', 'county': 'Nairobi County'},
+        {'id': 3, 'name': 'C', 'county': 'MURANGÁ'},
+        {'id': 4, 'name': 'D', 'county': 'KISUMU'},
+        {'id': 5, 'name': 'E', 'county': None},
+    ]
+
+
+class TestAttendanceByCounty:
+    """Test suite for county attendance averages."""
+
+    def test_average_per_county(self, sample_mps):
+        """Test MPs are grouped by canonical county and averaged."""
+        attendance = {1: 80.0, 2: 60.0, 3: 90.0, 5: 100.0}
+
+        assert attendance_by_county(sample_mps, attendance) == {
+            'Nairobi': pytest.approx(70.0),
+            "Murang'a": pytest.approx(90.0),
+        }
+
+    def test_county_from_constituency(self):
+        """Test the constituency's county is preferred over the stored one."""
+        mps = [
+            {'id': 1, 'constituency': 'Mvita (Mombasa County)', 'county': 'Kwale'},
+            {'id': 2, 'constituency': 'KISUMU COUNTY', 'county': None},
+            {'id': 3, 'constituency': 'Westlands', 'county': 'NAIROBI'},
+        ]
+
+        assert attendance_by_county(mps, {1: 80.0, 2: 60.0, 3: 90.0}) == {
+            'Mombasa': pytest.approx(80.0),
+            'Kisumu': pytest.approx(60.0),
+            'Nairobi': pytest.approx(90.0),
+        }
+
+    def test_counties_without_data_omitted(self, sample_mps):
+        """Test counties with no attendance data are left out, not zeroed."""
+        assert 'Kisumu' not in attendance_by_county(sample_mps, {1: 80.0})
+
+    def test_no_data(self, sample_mps):
+        """Test no attendance data gives no counties."""
+        assert attendance_by_county(sample_mps, {}) == {}
+
+
+class TestRankCounties:
+    """Test suite for county ranking."""
+
+    def test_highest_first(self):
+        """Test counties are ranked by attendance, ties alphabetical."""
+        ranking = rank_counties({'Nairobi': 70.0, 'Kisumu': 90.0, 'Embu': 70.0})
+
+        assert ranking == [('Kisumu', 90.0), ('Embu', 70.0), ('Nairobi', 70.0)]
+
+    def test_empty(self):
+        """Test ranking no counties."""
+        assert rank_counties({}) == []
+
+
+class TestCumulativeAttendance:
+    """Test suite for running attendance over a term."""
+
+    def test_running_rate(self):
+        """Test each entry covers the sessions so far."""
+        present = {
+            's1': {1: False, 2: True},
+            's2': {1: True, 2: True},
+            's3': {1: True, 2: False},
+            's4': {1: True, 2: False},
+        }
+
+        series = cumulative_attendance(present, ['s1', 's2', 's3', 's4'])
+
+        assert series[1] == pytest.approx([0.0, 50.0, 66.667, 75.0], abs=1e-3)
+        assert series[2] == pytest.approx([100.0, 100.0, 66.667, 50.0], abs=1e-3)
+
+    def test_missing_flags_count_as_absent(self):
+        """Test an MP missing from a session is absent and series stay aligned."""
+        present = {'s1': {1: True}, 's2': {1: True, 2: True}}
+
+        series = cumulative_attendance(present, ['s1', 's2', 's3'])
+
+        assert series[1] == pytest.approx([100.0, 100.0, 66.667], abs=1e-3)
+        assert series[2] == pytest.approx([0.0, 50.0, 33.333], abs=1e-3)
+
+    def test_only_ordered_sessions_counted(self):
+        """Test sessions not in the ordering are ignored."""
+        present = {'s1': {1: True}, 'extra': {1: False, 2: True}}
+
+        assert cumulative_attendance(present, ['s1']) == {1: [100.0]}
+
+    def test_no_sessions(self):
+        """Test an empty term has no series."""
+        assert cumulative_attendance({}, []) == {}
+
+
+class TestAttendanceStreaks:
+    """Test suite for attendance and absence runs."""
+
+    def test_longest_runs(self):
+        """Test the longest present and absent runs are found."""
+        present = [True, True, False, True, True, True, False, False, False, False, True]
+
+        assert attendance_streaks(present) == (3, 4)
+
+    def test_single_kind(self):
+        """Test an MP who never missed, or never attended, a sitting."""
+        assert attendance_streaks([True] * 5) == (5, 0)
+        assert attendance_streaks([False, False]) == (0, 2)
+
+    def test_no_sittings(self):
+        """Test empty input gives zero streaks."""
+        assert attendance_streaks([]) == (0, 0)
+
+
+class TestSilentMPs:
+    """Test suite for MPs present but silent."""
+
+    def test_present_without_statements(self, sample_mps):
+        """Test present MPs with no statements are listed by name."""
+        statements = {1: ['statement'], 2: [], 5: ['statement']}
+
+        assert silent_mps([4, 2, 3, 1], statements, sample_mps) == [2, 3, 4]
+
+    def test_absent_speakers_ignored(self, sample_mps):
+        """Test only present MPs can be silent."""
+        assert silent_mps([1], {}, sample_mps) == [1]
+        assert silent_mps([], {}, sample_mps) == []
@@ -221,6 +221,81 @@ class TestBillPositions:
         assert bills[0].position > 0
 
 
+class TestMoneyBillFlag:
+    """Test suite for flagging Money Bills during extraction."""
+    
+    def test_certified_bill_flagged(self, extractor):
+        """Test a bill certified as a Money Bill is flagged."""
+        text = "I have certified that the Finance Bill, 2024 is a Money Bill."
+        bills = extractor.extract_bill_references(text)
+        
+        assert len(bills) == 1
+        assert bills[0].money_bill is True
+    
+    def test_uncertified_bill_not_flagged(self, extractor):
+        """Test an ordinary bill reference is not flagged."""
+        bills = extractor.extract_bill_references("I support the Health Bill, 2024.")
+        
+        assert len(bills) == 1
+        assert bills[0].money_bill is False
+    
+    def test_not_a_money_bill(self, extractor):
+        """Test a bill said not to be a Money Bill is not flagged."""
+        bills = extractor.extract_bill_references("Bill No. 12 is not a Money Bill.")
+        
+        assert len(bills) == 1
+        assert bills[0].money_bill is False
+    
+    def test_only_certifying_sentence_counts(self, extractor):
+        """Test certification in one sentence does not flag bills in another."""
+        text = "The Finance Bill, 2024 is a Money Bill. The Health Bill, 2024 was read a First Time."
+        bills = extractor.extract_bill_references(text)
+        
+        flags = {bill.bill_type: bill.money_bill for bill in bills}
+        assert flags == {'Finance': True, 'Health': False}
+    
+    def test_later_mention_flags_bill(self, extractor):
+        """Test a certifying later mention flags the deduplicated bill."""
+        text = "Bill No. 7 was read. I have certified Bill No. 7 as a Money Bill."
+        bills = extractor.extract_bill_references(text)
+        
+        assert len(bills) == 1
+        assert bills[0].money_bill is True
+
+
+class TestAssentDate:
+    """Test suite for recording assent dates during extraction."""
+    
+    def test_assent_date_recorded(self, extractor):
+        """Test a bill announced as assented to gets the assent date."""
+        text = "The Finance Bill, 2024 was assented to on 26th June, 2024."
+        bills = extractor.extract_bill_references(text)
+        
+        assert len(bills) == 1
+        assert bills[0].assented_at == "2024-06-26"
+    
+    def test_no_assent_date(self, extractor):
+        """Test a bill not assented to has no assent date."""
+        bills = extractor.extract_bill_references("The Finance Bill, 2024 was read a Second Time.")
+        
+        assert bills[0].assented_at is None
+    
+    def test_assent_in_other_sentence_ignored(self, extractor):
+        """Test assent announced for one bill is not given to another."""
+        text = "Bill No. 4 was read. The Health Bill, 2024 was assented to on 1st July, 2024."
+        bills = extractor.extract_bill_references(text)
+        
+        dates = {extractor.format_bill_reference(bill): bill.assented_at for bill in bills}
+        assert dates == {"Bill No. 4": None, "Health Bill 2024": "2024-07-01"}
+    
+    def test_invalid_assent_date_ignored(self, extractor):
+        """Test a malformed assent date does not stop extraction."""
+        bills = extractor.extract_bill_references("Bill No. 9 was assented to on 2024-13-45.")
+        
+        assert len(bills) == 1
+        assert bills[0].assented_at is None
+
+
 class TestExtractFromStatements:
     """Test suite for extracting from statements."""
     
@@ -0,0 +1,196 @@
+"""
+Tests for bill progress tracking.
+"""
+from unittest.mock import patch
+
+import pytest
+
+from hansard_tales.analysis.bills import (
+    ASSENTED,
+    COMMITTEE_OF_THE_WHOLE_HOUSE,
+    DEFEATED,
+    FIRST_READING,
+    PASSED,
+    SECOND_READING,
+    THIRD_READING,
+    WITHDRAWN,
+    co_sponsorship_edges,
+    is_money_bill,
+    normalize_bill_title,
+    record_assent_date,
+    record_money_bill,
+    track_bill_progress,
+    valid_bill_transition,
+)
+
+
+class TestValidBillTransition:
+    """Test suite for bill status transitions."""
+
+    @pytest.mark.parametrize("old, new", [
+        (None, FIRST_READING),
+        (FIRST_READING, FIRST_READING),
+        (FIRST_READING, SECOND_READING),
+        (SECOND_READING, COMMITTEE_OF_THE_WHOLE_HOUSE),
+        (THIRD_READING, PASSED),
+        (PASSED, ASSENTED),
+        (SECOND_READING, WITHDRAWN),
+        (THIRD_READING, DEFEATED),
+    ])
+    def test_possible_transitions(self, old, new):
+        """Test staying put, advancing one stage, and early termination."""
+        assert valid_bill_transition(old, new)
+
+    @pytest.mark.parametrize("old, new", [
+        (FIRST_READING, THIRD_READING),
+        (THIRD_READING, SECOND_READING),
+        (PASSED, WITHDRAWN),
+        (WITHDRAWN, SECOND_READING),
+        (FIRST_READING, 'unknown'),
+        (None, 'unknown'),
+    ])
+    def test_impossible_transitions(self, old, new):
+        """Test skipped stages, reversals and leaving terminal statuses."""
+        assert not valid_bill_transition(old, new)
+
+
+class TestTrackBillProgress:
+    """Test suite for bill timelines across sittings."""
+
+    def test_statuses_ordered_by_sitting_date(self):
+        """Test sittings are processed by date regardless of input order."""
+        bills_by_session = {
+            '2024-03-12': [{'id': 'B1', 'status': SECOND_READING}],
+            '2024-03-05': [{'id': 'B1', 'status': FIRST_READING}, {'id': 'B2', 'status': FIRST_READING}],
+            '2024-03-19': [{'id': 'B1', 'status': SECOND_READING}, {'id': 'B2', 'status': WITHDRAWN}],
+        }
+
+        assert track_bill_progress(bills_by_session) == {
+            'B1': [FIRST_READING, SECOND_READING],
+            'B2': [FIRST_READING, WITHDRAWN],
+        }
+
+    @patch('hansard_tales.analysis.bills.logger')
+    def test_impossible_jump_warned(self, mock_logger):
+        """Test impossible transitions are kept but logged as warnings."""
+        progress = track_bill_progress({
+            '2024-03-05': [{'id': 'B1', 'status': FIRST_READING}],
+            '2024-03-12': [{'id': 'B1', 'status': PASSED}],
+        })
+
+        assert progress == {'B1': [FIRST_READING, PASSED]}
+        mock_logger.warning.assert_called_once()
+
+    def test_title_used_without_id(self):
+        """Test records without an id are grouped by normalized title."""
+        progress = track_bill_progress({
+            '2024-03-05': [{'title': 'The Finance Bill, 2025', 'status': FIRST_READING}],
+            '2024-03-12': [{'title': 'Finance Bill 2025', 'status': SECOND_READING}],
+        })
+
+        assert progress == {'finance bill 2025': [FIRST_READING, SECOND_READING]}
+
+    def test_records_without_status_skipped(self):
+        """Test records missing an id or status are ignored."""
+        assert track_bill_progress({'2024-03-05': [{'id': 'B1'}, {'status': FIRST_READING}]}) == {}
+        assert track_bill_progress({}) == {}
+
+
+class TestNormalizeBillTitle:
+    """Test suite for bill title keys."""
+
+    @pytest.mark.parametrize("title", [
+        "The Finance Bill, 2025",
+        "Finance Bill 2025",
+        "THE FINANCE BILL,2025",
+        "  the Finance  Bill, 2025. ",
+    ])
+    def test_variants_share_key(self, title):
+        """Test article, case, punctuation and spacing variants match."""
+        assert normalize_bill_title(title) == "finance bill 2025"
+
+    def test_distinct_bills_differ(self):
+        """Test different bills keep different keys."""
+        assert normalize_bill_title("Finance Bill, 2024") != normalize_bill_title("Finance Bill, 2025")
+        assert normalize_bill_title("The Theatre Bill") == "theatre bill"
+        assert normalize_bill_title("") == ""
+
+
+class TestRecordAssentDate:
+    """Test suite for recording assent on bill records."""
+
+    def test_sets_assented_at(self):
+        """Test the assent date is stored on the bill."""
+        bill = {'id': 'B1', 'status': ASSENTED}
+
+        assert record_assent_date(bill, "The Bill was assented to on 26th June, 2024.") == "2024-06-26"
+        assert bill['assented_at'] == "2024-06-26"
+
+    def test_no_assent_date_leaves_bill_unchanged(self):
+        """Test the bill is untouched when the text gives no assent date."""
+        bill = {'id': 'B1', 'status': PASSED}
+
+        assert record_assent_date(bill, "The Bill was passed.") is None
+        assert 'assented_at' not in bill
+
+
+class TestMoneyBill:
+    """Test suite for Money Bill detection."""
+
+    @pytest.mark.parametrize("text", [
+        "Hon. Members, I have certified that the Finance Bill, 2024 is a Money Bill.",
+        "The Speaker has issued a certificate under Article 114(2) that this is a Money Bill.",
+        "This Bill is a Money Bill within the meaning of Article 114 of the Constitution.",
+        "A MONEY BILL UNDER ARTICLE 114 OF THE CONSTITUTION",
+    ])
+    def test_certification_phrasing(self, text):
+        """Test the usual certification wording is recognised."""
+        assert is_money_bill(text)
+
+    @pytest.mark.parametrize("text", [
+        "This Bill is not a Money Bill within the meaning of Article 114 of the Constitution.",
+        "The Bill does not qualify as a Money Bill.",
+        "Members asked whether Money Bills need county input.",
+        "",
+    ])
+    def test_not_money_bill(self, text):
+        """Test negations and passing mentions are not certification."""
+        assert not is_money_bill(text)
+
+    def test_negation_limited_to_its_sentence(self):
+        """Test a negation about another Bill does not cancel a certification."""
+        text = "The Health Bill is not a Money Bill. The Finance Bill is a Money Bill."
+        assert is_money_bill(text)
+
+    def test_record_sets_flag(self):
+        """Test the flag is stored on the bill record either way."""
+        bill = {'id': 'B1', 'status': FIRST_READING}
+
+        assert record_money_bill(bill, "I have certified that this is a Money Bill.") is True
+        assert bill['money_bill'] is True
+
+        assert record_money_bill(bill, "Second Reading.") is False
+        assert bill['money_bill'] is False
+
+
+class TestCoSponsorshipEdges:
+    """Test suite for co-sponsorship counts."""
+
+    def test_pairs_counted_per_bill(self):
+        """Test each pair of sponsors is counted once for every bill they share."""
+        bills = [
+            {'id': 'B1', 'sponsor_mp_id': 3, 'co_sponsor_mp_ids': [1, 2]},
+            {'id': 'B2', 'sponsor_mp_id': 1, 'co_sponsor_mp_ids': [3, 3]},
+            {'id': 'B3', 'sponsor_mp_id': 2},
+        ]
+
+        assert co_sponsorship_edges(bills) == {(1, 2): 1, (1, 3): 2, (2, 3): 1}
+
+    def test_sponsor_listed_as_co_sponsor(self):
+        """Test a sponsor repeated among the co-sponsors is not paired with themself."""
+        assert co_sponsorship_edges([{'sponsor_mp_id': 1, 'co_sponsor_mp_ids': [1, None, 2]}]) == {(1, 2): 1}
+
+    def test_no_co_sponsors(self):
+        """Test bills with a single sponsor give no edges."""
+        assert co_sponsorship_edges([{'sponsor_mp_id': 1}, {'co_sponsor_mp_ids': []}]) == {}
+        assert co_sponsorship_edges([]) == {}
@@ -0,0 +1,149 @@
+"""
+Tests for constituency name normalization.
+"""
+
+import pytest
+
+from hansard_tales.processors.constituency_normalizer import (
+    KENYA_COUNTIES,
+    ConstituencyNormalizer,
+)
+
+
+@pytest.fixture
+def normalizer():
+    """Create a constituency normalizer instance for testing."""
+    return ConstituencyNormalizer()
+
+
+class TestNormalize:
+    """Test suite for basic constituency normalization."""
+
+    def test_all_caps(self, normalizer):
+        """Test all-caps names from the parliament website are title-cased."""
+        assert normalizer.normalize("EMBAKASI CENTRAL") == "Embakasi Central"
+
+    def test_extra_whitespace(self, normalizer):
+        """Test repeated whitespace is collapsed."""
+        assert normalizer.normalize("  Suba   South ") == "Suba South"
+
+    def test_constituency_suffix_removed(self, normalizer):
+        """Test a trailing 'Constituency' label is dropped."""
+        assert normalizer.normalize("Mvita Constituency") == "Mvita"
+
+    def test_apostrophe_and_hyphen(self, normalizer):
+        """Test apostrophes and hyphens keep sensible casing."""
+        assert normalizer.normalize("KANGEMA MURANG'A") == "Kangema Murang'a"
+        assert normalizer.normalize("LUNGA-LUNGA") == "Lunga-Lunga"
+
+    def test_empty_input(self, normalizer):
+        """Test missing names normalize to an empty string."""
+        assert normalizer.normalize(None) == ""
+        assert normalizer.normalize("") == ""
+
+
+class TestCountyQualifiers:
+    """Test suite for stripping county qualifiers."""
+
+    def test_county_outside_parentheses(self, normalizer):
+        """Test 'County (Constituency)' keeps the constituency."""
+        assert normalizer.canonicalize("Mombasa (Mvita)") == "Mvita"
+
+    def test_county_inside_parentheses(self, normalizer):
+        """Test 'Constituency (County)' keeps the constituency."""
+        assert normalizer.canonicalize("Mvita (Mombasa)") == "Mvita"
+        assert normalizer.canonicalize("Mvita (Mombasa County)") == "Mvita"
+
+    def test_all_caps_qualified(self, normalizer):
+        """Test qualified all-caps variants canonicalize the same way."""
+        assert normalizer.canonicalize("KISUMU (KISUMU EAST)") == "Kisumu East"
+        assert normalizer.canonicalize("HOMA BAY (HOMA BAY TOWN)") == "Homa Bay Town"
+
+    def test_accented_county(self, normalizer):
+        """Test accented county spellings are still recognised."""
+        assert normalizer.canonicalize("MURANGÁ (MATHIOYA)") == "Mathioya"
+
+    def test_non_county_parenthetical_kept(self, normalizer):
+        """Test parentheticals that are not counties are left alone."""
+        assert normalizer.canonicalize("Mvita (Old)") == "Mvita (Old)"
+
+    def test_unqualified_name_unchanged(self, normalizer):
+        """Test plain names only get normalized."""
+        assert normalizer.canonicalize("MVITA") == "Mvita"
+
+    def test_variants_agree(self, normalizer):
+        """Test every source format maps to the same key."""
+        variants = ["Mvita", "MVITA", "Mombasa (Mvita)", "Mvita Constituency"]
+        assert {normalizer.canonicalize(v) for v in variants} == {"Mvita"}
+
+
+class TestCounties:
+    """Test suite for county recognition."""
+
+    def test_forty_seven_counties(self):
+        """Test the county table is complete."""
+        assert len(KENYA_COUNTIES) == 47
+
+    def test_is_county(self, normalizer):
+        """Test county recognition ignores case and the 'County' suffix."""
+        assert normalizer.is_county("NAIROBI")
+        assert normalizer.is_county("Tana River County")
+        assert not normalizer.is_county("Westlands")
+
+    def test_canonical_county(self, normalizer):
+        """Test county names map to their canonical spelling."""
+        assert normalizer.canonical_county("NAIROBI") == "Nairobi"
+        assert normalizer.canonical_county("MURANGÁ") == "Murang'a"
+        assert normalizer.canonical_county("elgeyo-marakwet county") == "Elgeyo Marakwet"
+        assert normalizer.canonical_county("Westlands") is None
+        assert normalizer.canonical_county(None) is None
+
+    def test_county_of(self, normalizer):
+        """Test the county is read from a qualifier or a county seat."""
+        assert normalizer.county_of("Mombasa (Mvita)") == "Mombasa"
+        assert normalizer.county_of("MVITA (MOMBASA COUNTY)") == "Mombasa"
+        assert normalizer.county_of("Murang'a County") == "Murang'a"
+        assert normalizer.county_of("Mvita") is None
+        assert normalizer.county_of("Westlands (Nairobi City)") is None
+        assert normalizer.county_of(None) is None
+
+
+class TestDirectionalConstituencies:
+    """Test suite for constituencies distinguished by a compass qualifier."""
+
+    @pytest.mark.parametrize("variant", [
+        "Kuria East", "KURIA EAST", "Kuria-East", "Kuria E.", "kuria e", "Kuria East Constituency",
+    ])
+    def test_direction_variants_agree(self, normalizer, variant):
+        """Test spellings of a directional suffix canonicalize the same way."""
+        assert normalizer.canonicalize(variant) == "Kuria East"
+
+    def test_abbreviations(self, normalizer):
+        """Test abbreviated directions are spelled out."""
+        assert normalizer.canonicalize("Igembe Nth") == "Igembe North"
+        assert normalizer.canonicalize("IGEMBE C.") == "Igembe Central"
+        assert normalizer.canonicalize("Igembe/South") == "Igembe South"
+
+    def test_single_word_not_treated_as_direction(self, normalizer):
+        """Test a lone word is never expanded."""
+        assert normalizer.canonicalize("E") == "E"
+
+    def test_split_direction(self, normalizer):
+        """Test the base name is separated from a suffix or prefix direction."""
+        assert normalizer.split_direction("KURIA WEST") == ("Kuria", "West")
+        assert normalizer.split_direction("South Imenti") == ("Imenti", "South")
+        assert normalizer.split_direction("Mvita") == ("Mvita", "")
+
+    def test_related_constituencies(self, normalizer):
+        """Test siblings sharing a base name are found."""
+        known = ["Igembe North", "IGEMBE CENTRAL", "Igembe South", "Kuria East", "Kuria West", "Mvita"]
+
+        assert normalizer.related_constituencies("Igembe Nth", known) == ["Igembe Central", "Igembe South"]
+        assert normalizer.related_constituencies("Kuria", known) == ["Kuria East", "Kuria West"]
+
+    def test_no_related_constituencies(self, normalizer):
+        """Test constituencies without siblings have none."""
+        known = ["Kuria East", "Kuria West", "Mvita"]
+
+        assert normalizer.related_constituencies("Mvita", known) == []
+        assert normalizer.related_constituencies("", known) == []
@@ -18,7 +18,8 @@ from hansard_tales.database.init_db import (
     create_indexes,
     create_views,
     verify_schema,
-    initialize_database
+    initialize_database,
+    migrate_schema
 )
 
 
@@ -58,7 +59,8 @@ class TestDatabaseSchema:
             'mps',
             'mp_terms',
             'hansard_sessions',
-            'statements'
+            'statements',
+            'bills'
         ]
         
         for table in expected_tables:
@@ -92,6 +94,9 @@ class TestDatabaseSchema:
         assert 'constituency' in columns
         assert 'party' in columns
         assert 'photo_url' in columns
+        assert 'email' in columns
+        assert 'phone' in columns
+        assert 'membership_status' in columns
         assert 'first_elected_year' in columns
     
     def test_mp_terms_table_structure(self, db_connection):
@@ -125,6 +130,9 @@ class TestDatabaseSchema:
         assert 'title' in columns
         assert 'pdf_url' in columns
         assert 'pdf_path' in columns
+        assert 'volume' in columns
+        assert 'number' in columns
+        assert 'officers' in columns
         assert 'processed' in columns
     
     def test_statements_table_structure(self, db_connection):
@@ -231,6 +239,62 @@ class TestDatabaseSchema:
         
         assert len(tables) >= 5
     
+    def test_migrate_existing_schema(self, db_connection):
+        """Test a database from before later columns were added is migrated."""
+        cursor = db_connection.cursor()
+        cursor.execute("""
+            CREATE TABLE hansard_sessions (
+                id INTEGER PRIMARY KEY AUTOINCREMENT,
+                term_id INTEGER NOT NULL,
+                date DATE NOT NULL,
+                title TEXT,
+                pdf_url TEXT NOT NULL,
+                pdf_path TEXT,
+                processed BOOLEAN DEFAULT 0,
+                created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                UNIQUE(date, title)
+            )
+        """)
+        cursor.execute("""
+            INSERT INTO hansard_sessions (term_id, date, title, pdf_url)
+            VALUES (1, '2024-03-05', 'Existing', 'https://example.com/a.pdf')
+        """)
+        
+        added = migrate_schema(db_connection)
+        
+        assert 'hansard_sessions.volume' in added
+        assert 'hansard_sessions.number' in added
+        assert 'hansard_sessions.officers' in added
+        assert 'bills' in added
+        cursor.execute("UPDATE hansard_sessions SET volume = 'III', number = '42'")
+        cursor.execute("SELECT title, volume, number FROM hansard_sessions")
+        assert cursor.fetchone() == ('Existing', 'III', '42')
+    
+    def test_migrate_existing_mps_table(self, db_connection):
+        """Test contact columns are added to an older mps table."""
+        cursor = db_connection.cursor()
+        cursor.execute("""
+            CREATE TABLE mps (
+                id INTEGER PRIMARY KEY AUTOINCREMENT,
+                name TEXT NOT NULL,
+                constituency TEXT NOT NULL,
+                party TEXT,
+                photo_url TEXT
+            )
+        """)
+        
+        added = migrate_schema(db_connection)
+        
+        assert added == ['bills', 'mps.email', 'mps.phone', 'mps.membership_status']
+    
+    def test_migrate_current_schema_is_noop(self, db_connection):
+        """Test migrating an up-to-date or empty database changes nothing."""
+        assert migrate_schema(db_connection) == []
+        
+        create_tables(db_connection)
+        
+        assert migrate_schema(db_connection) == []
+    
     def test_foreign_key_constraints(self, db_connection):
         """Test that foreign key constraints are enforced."""
         create_tables(db_connection)
@@ -0,0 +1,204 @@
+"""
+Tests for shared date parsing helpers.
+"""
+
+from datetime import date
+
+import pytest
+
+from hansard_tales.dates import (
+    InvalidDateError,
+    extract_assent_date,
+    extract_date,
+    extract_sitting_references,
+    ordinal_suffix,
+    parse_date_range,
+    parse_textual_date,
+    strip_ordinal_suffix,
+)
+
+
+class TestStripOrdinalSuffix:
+    """Test suite for ordinal suffix removal."""
+
+    @pytest.mark.parametrize("text, expected", [
+        ("1st", "1"),
+        ("2nd", "2"),
+        ("3rd", "3"),
+        ("4th", "4"),
+        ("21st", "21"),
+        ("22nd", "22"),
+        ("11th", "11"),
+        ("12th", "12"),
+        ("13th", "13"),
+        ("111th", "111"),
+        ("21ST", "21"),
+    ])
+    def test_strips_ordinals(self, text, expected):
+        """Test correct ordinals lose their suffix."""
+        assert strip_ordinal_suffix(text) == expected
+
+    @pytest.mark.parametrize("text", ["11st", "12nd", "21th", "3th", "1stly", "first", "2024"])
+    def test_leaves_non_ordinals(self, text):
+        """Test malformed ordinals and other words are left alone."""
+        assert strip_ordinal_suffix(text) == text
+
+    def test_strips_within_text(self):
+        """Test ordinals inside longer text are stripped."""
+        assert strip_ordinal_suffix("Tuesday, 5th March and 21st May") == "Tuesday, 5 March and 21 May"
+
+    def test_empty_text(self):
+        """Test empty text is returned unchanged."""
+        assert strip_ordinal_suffix("") == ""
+
+    def test_ordinal_suffix(self):
+        """Test the suffix for irregular teens."""
+        assert [ordinal_suffix(n) for n in (1, 11, 101, 112, 23)] == ["st", "th", "st", "th", "rd"]
+
+
+class TestParseTextualDate:
+    """Test suite for month-name date parsing."""
+
+    @pytest.mark.parametrize("text, expected", [
+        ("Tuesday, 5th March 2024", "2024-03-05"),
+        ("Hansard Report - 21st November, 2023", "2023-11-21"),
+        ("March 15, 2024", "2024-03-15"),
+        ("March 15th 2024", "2024-03-15"),
+        ("THURSDAY, 13TH JUNE 2024", "2024-06-13"),
+    ])
+    def test_parses_dates(self, text, expected):
+        """Test both day-first and month-first forms."""
+        assert parse_textual_date(text) == expected
+
+    def test_first_date_wins(self):
+        """Test the earliest date in the text is used."""
+        assert parse_textual_date("March 1, 2024 replaces 28th February 2024") == "2024-03-01"
+
+    def test_no_date(self):
+        """Test text without a textual date."""
+        assert parse_textual_date("Hansard Report") is None
+        assert parse_textual_date("") is None
+
+
+class TestExtractDate:
+    """Test suite for date extraction with invalid-date reporting."""
+
+    def test_numeric_before_textual(self):
+        """Test numeric dates take priority over textual ones."""
+        assert extract_date("5th March 2024, filed 06/03/2024") == "2024-03-06"
+
+    def test_matched_but_invalid(self):
+        """Test an impossible date raises an error naming the substring."""
+        with pytest.raises(InvalidDateError, match="2025-13-45") as exc_info:
+            extract_date("Hansard 2025-13-45")
+
+        assert exc_info.value.text == "2025-13-45"
+
+    def test_invalid_textual_date(self):
+        """Test an impossible date written in words is reported."""
+        with pytest.raises(InvalidDateError, match="31 February 2024"):
+            extract_date("Thursday, 31st February 2024")
+
+    def test_falls_back_to_later_valid_date(self):
+        """Test an invalid match is skipped when a valid date follows."""
+        assert extract_date("31/02/2024 corrected to 29/02/2024") == "2024-02-29"
+        assert extract_date("2024-02-30 / Tuesday, 5th March 2024") == "2024-03-05"
+
+    def test_no_date_is_none(self):
+        """Test text with nothing date-like returns None rather than raising."""
+        assert extract_date("Hansard Report") is None
+        assert extract_date("") is None
+
+
+class TestExtractAssentDate:
+    """Test suite for Bill assent dates."""
+
+    def test_assent_date(self):
+        """Test the date following the assent phrase is extracted."""
+        text = "The Finance Bill, 2024 was assented to on Wednesday, 26th June, 2024."
+        assert extract_assent_date(text) == "2024-06-26"
+
+    def test_other_dates_ignored(self):
+        """Test dates not introduced by the assent phrase are not used."""
+        text = ("Hon. Members, on 12th March, 2024, I received a message that the "
+                "Bill was assented to on 1st April, 2024.")
+        assert extract_assent_date(text) == "2024-04-01"
+
+    def test_no_assent_phrase(self):
+        """Test text without an assent phrase has no assent date."""
+        assert extract_assent_date("The Bill was read a Third Time on 5th March 2024.") is None
+        assert extract_assent_date("The Bill was assented to on a later date.") is None
+        assert extract_assent_date("") is None
+
+    def test_invalid_assent_date(self):
+        """Test an impossible assent date raises an error."""
+        with pytest.raises(InvalidDateError):
+            extract_assent_date("assented to on 31st February 2024")
+
+
+class TestExtractSittingReferences:
+    """Test suite for references to other sittings."""
+
+    def test_absolute_dates(self):
+        """Test written dates are resolved regardless of session date."""
+        text = "As I said on 5th March 2024 and again on 12/03/2024, the Bill is urgent."
+        assert extract_sitting_references(text) == ["2024-03-05", "2024-03-12"]
+
+    def test_relative_references(self):
+        """Test relative references resolve against the session date."""
+        # 2024-03-07 is a Thursday
+        text = "As I said yesterday, and on Tuesday, and last week, this House must act."
+        assert extract_sitting_references(text, "2024-03-07") == [
+            "2024-02-29", "2024-03-05", "2024-03-06"
+        ]
+
+    def test_same_weekday_means_previous_week(self):
+        """Test a reference to the sitting's own weekday means a week earlier."""
+        assert extract_sitting_references("last Thursday", date(2024, 3, 7)) == ["2024-02-29"]
+
+    def test_relative_ignored_without_session_date(self):
+        """Test relative references cannot be resolved without a date."""
+        assert extract_sitting_references("As I said yesterday and on Tuesday.") == []
+
+    def test_weekday_of_written_date_not_relative(self):
+        """Test a weekday introducing a written date is not resolved separately."""
+        text = "on Tuesday, 5th March 2024"
+        assert extract_sitting_references(text, "2024-03-14") == ["2024-03-05"]
+
+    def test_own_date_and_invalid_dates_excluded(self):
+        """Test the sitting's own date and impossible dates are not reported."""
+        text = "Today, 7th March 2024, we revisit the 31st February 2024 sitting."
+        assert extract_sitting_references(text, "2024-03-07") == []
+        assert extract_sitting_references("") == []
+
+
+class TestParseDateRange:
+    """Test suite for start..end date ranges."""
+
+    def test_iso_range(self):
+        """Test an ISO range gives its start and end dates."""
+        assert parse_date_range("2025-01-01..2025-03-31") == (date(2025, 1, 1), date(2025, 3, 31))
+
+    def test_other_formats_and_single_day(self):
+        """Test ends in any supported format, including a one-day range."""
+        assert parse_date_range("5th March 2024 .. 05/03/2024") == (date(2024, 3, 5), date(2024, 3, 5))
+
+    @pytest.mark.parametrize("text,message", [
+        ("2025-01-01", "separated by"),
+        ("2025-01-01..2025-02-01..2025-03-01", "separated by"),
+        ("2025-01-01..", "date at each end"),
+        ("..2025-01-01", "date at each end"),
+        ("garbage 2025-01-01 junk..see 2025-02-01 x", "date at each end"),
+        ("2025-01-01..1st February 2025 onwards", "date at each end"),
+        ("2025-03-31..2025-01-01", "starts after it ends"),
+        ("", "separated by"),
+    ])
+    def test_invalid_ranges(self, text, message):
+        """Test malformed and reversed ranges are rejected."""
+        with pytest.raises(ValueError, match=message):
+            parse_date_range(text)
+
+    def test_impossible_date(self):
+        """Test an impossible date names the offending text."""
+        with pytest.raises(InvalidDateError, match="2025-02-30"):
+            parse_date_range("2025-02-30..2025-03-31")
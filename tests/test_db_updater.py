@@ -29,7 +29,8 @@ def temp_db():
     conn = sqlite3.Connection(db_path)
     cursor = conn.cursor()
     
-    # Create tables (simplified schema for testing)
+    # Create tables (simplified schema for testing, as created before
+    # later columns were added, so migration is exercised)
     cursor.execute("""
         CREATE TABLE parliamentary_terms (
             id INTEGER PRIMARY KEY,
@@ -307,6 +308,60 @@ class TestSessionManagement:
         assert processed == 1
         
         conn.close()
+    
+    def test_update_session_volume(self, updater):
+        """Test recording the Official Report volume and number."""
+        conn = updater.get_connection()
+        cursor = conn.cursor()
+        
+        session_id = updater.get_or_create_session(
+            cursor, "2024-12-04", "Test Session", "https://example.com/test.pdf"
+        )
+        
+        updater.update_session_volume(cursor, session_id, "III", "42")
+        conn.commit()
+        
+        cursor.execute("SELECT volume, number FROM hansard_sessions WHERE id = ?", (session_id,))
+        row = cursor.fetchone()
+        
+        assert row['volume'] == "III"
+        assert row['number'] == "42"
+        
+        conn.close()
+    
+    def test_existing_database_migrated(self, updater):
+        """Test tables and columns missing from an older database are added on connect."""
+        conn = updater.get_connection()
+        cursor = conn.cursor()
+        
+        cursor.execute("PRAGMA table_info(hansard_sessions)")
+        columns = {row['name'] for row in cursor.fetchall()}
+        
+        assert {'volume', 'number', 'officers'} <= columns
+        
+        cursor.execute("SELECT name FROM sqlite_master WHERE type='table' AND name='bills'")
+        assert cursor.fetchone() is not None
+        
+        conn.close()
+    
+    def test_update_session_officers(self, updater):
+        """Test recording the officers named in a sitting."""
+        conn = updater.get_connection()
+        cursor = conn.cursor()
+        
+        session_id = updater.get_or_create_session(
+            cursor, "2024-12-04", "Test Session", "https://example.com/test.pdf"
+        )
+        
+        updater.update_session_officers(cursor, session_id, {"Clerk": "Mr. Samuel Njoroge"})
+        conn.commit()
+        
+        cursor.execute("SELECT officers FROM hansard_sessions WHERE id = ?", (session_id,))
+        row = cursor.fetchone()
+        
+        assert json.loads(row['officers']) == {"Clerk": "Mr. Samuel Njoroge"}
+        
+        conn.close()
 
 
 class TestStatementInsertion:
@@ -370,6 +425,48 @@ class TestStatementInsertion:
         conn.close()
 
 
+class TestBillStorage:
+    """Test suite for recording bills."""
+    
+    def test_upsert_bill_new(self, updater):
+        """Test recording a bill for the first time."""
+        conn = updater.get_connection()
+        cursor = conn.cursor()
+        
+        bill = BillReference("", "2024", bill_type="Finance", money_bill=True, assented_at="2024-06-26")
+        bill_id = updater.upsert_bill(cursor, bill)
+        
+        cursor.execute("SELECT * FROM bills WHERE id = ?", (bill_id,))
+        row = cursor.fetchone()
+        
+        assert row['reference'] == "Finance Bill 2024"
+        assert row['money_bill'] == 1
+        assert row['assented_at'] == "2024-06-26"
+        
+        conn.close()
+    
+    def test_upsert_bill_keeps_recorded_details(self, updater):
+        """Test a later plain mention does not clear the flag or assent date."""
+        conn = updater.get_connection()
+        cursor = conn.cursor()
+        
+        first = BillReference("", "2024", bill_type="Finance", money_bill=True)
+        assent = BillReference("", "2024", bill_type="Finance", assented_at="2024-06-26")
+        plain = BillReference("", "2024", bill_type="Finance")
+        
+        bill_id = updater.upsert_bill(cursor, first)
+        assert updater.upsert_bill(cursor, assent) == bill_id
+        assert updater.upsert_bill(cursor, plain) == bill_id
+        
+        cursor.execute("SELECT money_bill, assented_at FROM bills WHERE id = ?", (bill_id,))
+        row = cursor.fetchone()
+        
+        assert row['money_bill'] == 1
+        assert row['assented_at'] == "2024-06-26"
+        
+        conn.close()
+
+
 class TestProcessHansardPDF:
     """Test suite for complete PDF processing."""
     
@@ -424,6 +521,40 @@ class TestProcessHansardPDF:
         assert result['statements'] == 1
         assert result['unique_mps'] == 1
     
+    def test_process_hansard_pdf_records_bills(self, updater):
+        """Test bills certified or assented to from the Chair are recorded."""
+        pages = [{
+            'page_number': 1,
+            'text': (
+                "Hon. Speaker: I have certified that the Finance Bill, 2024 is a Money Bill. "
+                "The Health Bill, 2023 was assented to on 1st July, 2024."
+            )
+        }]
+        statements = [Statement("John Doe", "I support the Finance Bill, 2024.", 0, 100, page_number=1)]
+        
+        with patch.object(updater.pdf_processor, 'extract_text_from_pdf', return_value={'pages': pages}), \
+                patch.object(updater.mp_identifier, 'extract_statements_from_pages', return_value=statements):
+            result = updater.process_hansard_pdf(
+                "/path/to/test.pdf",
+                "https://example.com/test.pdf",
+                "2024-12-04",
+                "Test Session"
+            )
+        
+        assert result['status'] == 'success'
+        
+        conn = updater.get_connection()
+        cursor = conn.cursor()
+        cursor.execute("SELECT reference, money_bill, assented_at FROM bills ORDER BY reference")
+        rows = [tuple(row) for row in cursor.fetchall()]
+        
+        assert rows == [
+            ("Finance Bill 2024", 1, None),
+            ("Health Bill 2023", 0, "2024-07-01"),
+        ]
+        
+        conn.close()
+    
     def test_process_hansard_pdf_skip_duplicate(self, updater):
         """Test skipping already processed session."""
         conn = updater.get_connection()
@@ -0,0 +1,163 @@
+"""
+Tests for CSV, bundle and caption exporters.
+"""
+import csv
+import io
+import json
+from datetime import date, timedelta
+
+import pytest
+
+from hansard_tales.exporters import SCORECARD_FIELDS, write_bundle, write_csv, write_scorecard_csv, write_webvtt
+from hansard_tales.processors.mp_identifier import Statement
+from hansard_tales.statements import TimedStatement
+
+
+@pytest.fixture
+def sample_mps():
+    """Create sample MP records."""
+    return [
+        {'id': 1, 'name': 'John Mbadi', 'constituency': 'Suba South', 'party': 'ODM'},
+        {'id': 2, 'name': 'Alice Wahome', 'constituency': 'Kandara', 'party': 'UDA'},
+    ]
+
+
+def read_rows(output):
+    """Parse CSV text back into dictionaries."""
+    return list(csv.DictReader(io.StringIO(output.getvalue())))
+
+
+class TestWriteCSV:
+    """Test suite for the shared CSV writer."""
+
+    def test_quotes_commas_and_quotes(self):
+        """Test values containing commas and quotes round-trip."""
+        output = io.StringIO()
+        write_csv(output, ['name'], [{'name': 'MBADI, JOHN "JM"'}])
+        assert read_rows(output)[0]['name'] == 'MBADI, JOHN "JM"'
+
+    def test_missing_and_extra_keys(self):
+        """Test missing keys are blank and unknown keys are ignored."""
+        output = io.StringIO()
+        write_csv(output, ['name', 'party'], [{'name': 'John', 'county': 'Nairobi'}])
+        rows = read_rows(output)
+        assert rows[0] == {'name': 'John', 'party': ''}
+
+
+class TestWriteScorecardCSV:
+    """Test suite for scorecard export."""
+
+    def test_header_columns(self, sample_mps):
+        """Test the scorecard header matches the published columns."""
+        output = io.StringIO()
+        write_scorecard_csv(output, sample_mps, {})
+        header = output.getvalue().splitlines()[0]
+        assert header.split(',') == SCORECARD_FIELDS
+
+    def test_scores_looked_up_by_id(self, sample_mps):
+        """Test each MP gets their own score."""
+        output = io.StringIO()
+        write_scorecard_csv(output, sample_mps, {1: 72.5, 2: 40})
+        rows = read_rows(output)
+        assert rows[0]['score'] == '72.50'
+        assert rows[1]['score'] == '40.00'
+        assert rows[1]['constituency'] == 'Kandara'
+
+    def test_missing_score_blank(self, sample_mps):
+        """Test MPs without a score get an empty score cell."""
+        output = io.StringIO()
+        write_scorecard_csv(output, sample_mps, {1: 72.5})
+        rows = read_rows(output)
+        assert rows[1]['score'] == ''
+
+    def test_no_mps_writes_header_only(self):
+        """Test an empty MP list still produces a header."""
+        output = io.StringIO()
+        write_scorecard_csv(output, [], {})
+        assert output.getvalue().strip() == ','.join(SCORECARD_FIELDS)
+
+
+class TestWriteBundle:
+    """Test suite for JSONL bundle export."""
+
+    def test_typed_records_one_per_line(self, sample_mps):
+        """Test each record is written on its own line tagged with its type."""
+        output = io.StringIO()
+        sessions = [{'date': '2024-03-05', 'title': 'Hansard Report - Tuesday, 5th March 2024'}]
+        bills = [{'id': 'B1', 'status': 'passed'}]
+
+        write_bundle(output, sample_mps, sessions, bills)
+
+        lines = [json.loads(line) for line in output.getvalue().splitlines()]
+        assert [line['type'] for line in lines] == ['mp', 'mp', 'session', 'bill']
+        assert lines[1]['record'] == sample_mps[1]
+        assert lines[3]['record'] == bills[0]
+
+    def test_dates_and_non_ascii_written_as_text(self):
+        """Test dates become strings and names keep their characters."""
+        output = io.StringIO()
+
+        write_bundle(output, [{'name': "Ng'ang'a Mwangi – Jr"}], [{'date': date(2024, 3, 5)}], [])
+
+        assert "Ng'ang'a Mwangi – Jr" in output.getvalue()
+        assert json.loads(output.getvalue().splitlines()[1])['record'] == {'date': '2024-03-05'}
+
+    def test_empty_bundle(self):
+        """Test an empty dataset writes nothing."""
+        output = io.StringIO()
+        write_bundle(output, [], [], [])
+        assert output.getvalue() == ""
+
+
+def timed(name, text, start, end):
+    """Create a timed statement spanning start to end seconds."""
+    statement = Statement(mp_name=name, text=text, start_position=0, end_position=len(text))
+    return TimedStatement(statement=statement, start=timedelta(seconds=start), end=timedelta(seconds=end))
+
+
+class TestWriteWebVTT:
+    """Test suite for WebVTT caption export."""
+
+    def test_cues_with_voices(self):
+        """Test each statement becomes a cue voiced by its speaker."""
+        output = io.StringIO()
+
+        write_webvtt(output, [
+            timed("John Mbadi", "Thank you, Hon. Speaker.\nI rise to support.", 5, 12.5),
+            timed("Aden Duale", "I second.", 3725, 3730),
+        ])
+
+        assert output.getvalue() == (
+            "WEBVTT\n"
+            "\n00:00:05.000 --> 00:00:12.500\n<v John Mbadi>Thank you, Hon. Speaker. I rise to support.\n"
+            "\n01:02:05.000 --> 01:02:10.000\n<v Aden Duale>I second.\n"
+        )
+
+    def test_overlapping_and_zero_length_cues(self):
+        """Test cues are ordered, clipped at the next cue, lengthened and never share a start."""
+        output = io.StringIO()
+
+        write_webvtt(output, [
+            timed("Aden Duale", "Second.", 20, 20),
+            timed("John Mbadi", "First.", 10, 25),
+            timed("Alice Wahome", "Third.", 20, 20),
+        ])
+
+        assert "00:00:10.000 --> 00:00:20.000\n<v John Mbadi>First." in output.getvalue()
+        assert "00:00:20.000 --> 00:00:21.000\n<v Aden Duale>Second." in output.getvalue()
+        assert "00:00:21.000 --> 00:00:22.000\n<v Alice Wahome>Third." in output.getvalue()
+        assert output.getvalue().index("First.") < output.getvalue().index("Second.")
+
+    def test_markup_escaped(self):
+        """Test characters that are markup in WebVTT are escaped."""
+        output = io.StringIO()
+
+        write_webvtt(output, [timed("Peter <Jr>", "Clause 3 & 4 > clause 5", 0, 2)])
+
+        assert "<v Peter &lt;Jr&gt;>Clause 3 &amp; 4 &gt; clause 5\n" in output.getvalue()
+
+    def test_no_statements(self):
+        """Test an empty caption file is still valid WebVTT."""
+        output = io.StringIO()
+        write_webvtt(output, [])
+        assert output.getvalue() == "WEBVTT\n"
@@ -0,0 +1,123 @@
+"""
+Tests for CSV importers.
+"""
+import io
+
+import pytest
+
+from hansard_tales.exporters import write_bundle
+from hansard_tales.importers import read_bundle, stream_mps_csv
+from hansard_tales.validation import ValidationError
+
+
+SAMPLE_CSV = (
+    "name,constituency,party,photo_url\n"
+    "John Mbadi,Suba South,ODM,https://parliament.go.ke/mbadi.jpg\n"
+    " ,Kandara,UDA,\n"
+    "Opiyo Wandayi,Ugunja,ODM,ftp://example.com/photo.jpg\n"
+)
+
+
+def collect(text):
+    """Stream CSV text and collect each (mp, error) pair."""
+    results = []
+    count = stream_mps_csv(io.StringIO(text), lambda mp, error: results.append((mp, error)))
+    return count, results
+
+
+class TestStreamMPsCSV:
+    """Test suite for streaming MP CSV import."""
+
+    def test_every_row_passed_to_callback(self):
+        """Test each row is delivered in order with its validation result."""
+        count, results = collect(SAMPLE_CSV)
+
+        assert count == 3
+        assert [mp['constituency'] for mp, _ in results] == ['Suba South', 'Kandara', 'Ugunja']
+
+    def test_valid_row(self):
+        """Test a valid row has no error."""
+        _, results = collect(SAMPLE_CSV)
+        mp, error = results[0]
+
+        assert error is None
+        assert mp == {
+            'name': 'John Mbadi',
+            'constituency': 'Suba South',
+            'party': 'ODM',
+            'photo_url': 'https://parliament.go.ke/mbadi.jpg',
+        }
+
+    def test_invalid_rows_reported_with_line(self):
+        """Test validation errors name the CSV line."""
+        _, results = collect(SAMPLE_CSV)
+
+        assert results[1][0]['name'] is None
+        assert results[1][1].problems == ["line 3: name is required"]
+        assert "line 4: photo_url" in str(results[2][1])
+
+    def test_extra_cells_reported(self):
+        """Test rows with more cells than the header are flagged."""
+        _, results = collect("name,constituency\nJohn Mbadi,Suba South,extra\n")
+
+        assert results[0][1].problems == ["line 2: row has more cells than the header"]
+
+    def test_callback_can_stop_import(self):
+        """Test an exception raised by the callback stops the import."""
+        seen = []
+
+        def stop_on_error(mp, error):
+            seen.append(mp)
+            if error:
+                raise error
+
+        with pytest.raises(ValidationError, match="line 3"):
+            stream_mps_csv(io.StringIO(SAMPLE_CSV), stop_on_error)
+
+        assert len(seen) == 2
+
+    def test_missing_required_column(self):
+        """Test a header without required columns is rejected up front."""
+        with pytest.raises(ValidationError, match="missing column: constituency"):
+            stream_mps_csv(io.StringIO("name,party\nJohn Mbadi,ODM\n"), lambda mp, error: None)
+
+    def test_empty_file(self):
+        """Test an empty file is rejected for lacking a header."""
+        with pytest.raises(ValidationError):
+            stream_mps_csv(io.StringIO(""), lambda mp, error: None)
+
+
+class TestReadBundle:
+    """Test suite for JSONL bundle import."""
+
+    def test_round_trip(self):
+        """Test a written bundle reads back to the same records."""
+        mps = [{'id': 1, 'name': 'John Mbadi', 'party_history': [{'party': 'ODM', 'from': '2017-08-31'}]}]
+        sessions = [{'date': '2024-03-05', 'pdf_url': 'https://parliament.go.ke/a.pdf'}]
+        bills = [{'id': 'B1', 'sponsor_mp_id': 1, 'status': 'assented', 'assented_at': '2024-06-26'}]
+        output = io.StringIO()
+        write_bundle(output, mps, sessions, bills)
+
+        assert read_bundle(io.StringIO(output.getvalue())) == (mps, sessions, bills)
+
+    def test_blank_lines_ignored(self):
+        """Test blank lines between records are skipped."""
+        text = '\n{"type": "bill", "record": {"id": "B1"}}\n\n'
+        assert read_bundle(io.StringIO(text)) == ([], [], [{'id': 'B1'}])
+
+    def test_malformed_lines_reported(self):
+        """Test every bad line is reported by number and nothing is returned."""
+        text = (
+            '{"type": "mp", "record": {"id": 1}}\n'
+            'not json\n'
+            '{"type": "vote", "record": {}}\n'
+            '{"type": "bill", "record": [1, 2]}\n'
+        )
+        with pytest.raises(ValidationError) as exc_info:
+            read_bundle(io.StringIO(text))
+
+        problems = exc_info.value.problems
+        assert len(problems) == 3
+        assert problems[0].startswith("line 2: invalid JSON")
+        assert problems[1] == "line 3: unknown record type 'vote'"
+        assert problems[2] == "line 4: record is not an object"
@@ -0,0 +1,67 @@
+"""
+Tests for statement language detection.
+"""
+
+import pytest
+
+from hansard_tales.analysis.language import (
+    LANGUAGE_ENGLISH,
+    LANGUAGE_SWAHILI,
+    LANGUAGE_UNKNOWN,
+    detect_language,
+    language_mix,
+)
+from hansard_tales.processors.mp_identifier import Statement
+
+
+class TestDetectLanguage:
+    """Test suite for per-statement language detection."""
+
+    def test_english(self):
+        """Test an English statement."""
+        assert detect_language("The people of this constituency are asking for water.") == LANGUAGE_ENGLISH
+
+    def test_swahili(self):
+        """Test a Kiswahili statement."""
+        text = "Asante sana Mheshimiwa Spika. Watu wa eneo hili wanahitaji maji kwa sababu ni muhimu."
+        assert detect_language(text) == LANGUAGE_SWAHILI
+
+    def test_mixed_statement_uses_majority(self):
+        """Test code-switching statements take the dominant language."""
+        text = "Asante sana Spika. The roads in this county are in a terrible state and we need them repaired."
+        assert detect_language(text) == LANGUAGE_ENGLISH
+
+    def test_annotations_ignored(self):
+        """Test editorial annotations do not affect detection."""
+        assert detect_language("Asante sana. (Applause from the Members on the floor)") == LANGUAGE_SWAHILI
+
+    def test_unknown(self):
+        """Test text without function words, or an even split, is unknown."""
+        assert detect_language("Kamukunji!") == LANGUAGE_UNKNOWN
+        assert detect_language("the na") == LANGUAGE_UNKNOWN
+        assert detect_language("") == LANGUAGE_UNKNOWN
+
+
+class TestLanguageMix:
+    """Test suite for sitting-level language mix."""
+
+    def test_weighted_by_words(self):
+        """Test each statement's words count toward its language."""
+        statements = [
+            Statement("A", "We support the Bill and the amendments to it.", 0, 10),
+            Statement("B", "Asante sana Mheshimiwa.", 10, 20),
+            Statement("C", "Hear!", 20, 30),
+        ]
+
+        mix = language_mix(statements)
+
+        assert mix == {
+            LANGUAGE_ENGLISH: pytest.approx(9 / 13),
+            LANGUAGE_SWAHILI: pytest.approx(3 / 13),
+            LANGUAGE_UNKNOWN: pytest.approx(1 / 13),
+        }
+
+    def test_empty_sitting(self):
+        """Test a sitting without speech has no mix."""
+        assert language_mix([]) == {}
+        assert language_mix([Statement("A", "(Applause)", 0, 10)]) == {}
@@ -7,13 +7,20 @@ speaker pattern matching, statement extraction, and name normalization.
 
 import json
 import tempfile
+from concurrent.futures import ThreadPoolExecutor
 from pathlib import Path
 from unittest.mock import Mock, patch
 
 import pytest
 
 # Import the identifier module
-from hansard_tales.processors.mp_identifier import MPIdentifier, Statement
+from hansard_tales.processors.mp_identifier import (
+    STATEMENT_POINT_OF_ORDER,
+    STATEMENT_PROCEDURAL,
+    STATEMENT_SUBSTANTIVE,
+    MPIdentifier,
+    Statement,
+)
 
 
 @pytest.fixture
@@ -113,6 +120,184 @@ class TestNameNormalization:
         """Test normalizing mixed case name."""
         result = identifier.normalize_mp_name("jOhN dOe")
         assert result == "John Doe"
+    
+    def test_normalize_keeps_initials_by_default(self, identifier):
+        """Test middle initials are preserved unless asked otherwise."""
+        assert identifier.normalize_mp_name("JOHN K. MBADI") == "John K. Mbadi"
+        assert identifier.normalize_mp_name("John K Mbadi") == "John K Mbadi"
+        assert identifier.normalize_mp_name("John K. Mbadi") != identifier.normalize_mp_name("John Mbadi")
+    
+    def test_normalize_drops_initials(self, identifier):
+        """Test initials with or without a period can be dropped."""
+        assert identifier.normalize_mp_name("John K. Mbadi", keep_initials=False) == "John Mbadi"
+        assert identifier.normalize_mp_name("John K Mbadi", keep_initials=False) == "John Mbadi"
+        assert identifier.normalize_mp_name("J. Mbadi", keep_initials=False) == "Mbadi"
+    
+    @pytest.mark.parametrize("workers", [0, 1, 3, -1])
+    def test_normalize_batch_preserves_order(self, identifier, workers):
+        """Test batch normalization returns names in input order for any worker count."""
+        names = [f"JOHN K. DOE{i} (Nairobi)" for i in range(50)]
+        
+        result = identifier.normalize_mp_names(names, workers=workers)
+        
+        assert result == [identifier.normalize_mp_name(name) for name in names]
+    
+    @pytest.mark.parametrize("workers,cpu_count,expected", [(0, 4, 4), (-1, 4, 4), (0, None, 1), (3, 4, 3)])
+    def test_normalize_batch_worker_count(self, identifier, workers, cpu_count, expected):
+        """Test workers <= 0 uses one worker per CPU and a positive count is used as given."""
+        with patch('hansard_tales.processors.mp_identifier.os.cpu_count', return_value=cpu_count), \
+                patch('hansard_tales.processors.mp_identifier.ThreadPoolExecutor',
+                      wraps=ThreadPoolExecutor) as executor:
+            identifier.normalize_mp_names(["John Mbadi"], workers=workers)
+        
+        executor.assert_called_once_with(max_workers=expected)
+    
+    def test_normalize_batch_options(self, identifier):
+        """Test keep_initials is applied to every name in a batch."""
+        assert identifier.normalize_mp_names(["John K. Mbadi", "J. Mbadi"], keep_initials=False) == [
+            "John Mbadi", "Mbadi"
+        ]
+        assert identifier.normalize_mp_names([]) == []
+    
+    @pytest.mark.parametrize("label", ["Hon. ", "(Dr) ", "Hon. (Dr.) ", "", "   "])
+    def test_title_only_labels_have_no_name(self, identifier, label):
+        """Test labels that are only titles are not treated as names."""
+        assert not identifier.has_name(label)
+    
+    def test_named_labels_have_name(self, identifier):
+        """Test real names, with or without titles, are accepted."""
+        assert identifier.has_name("Hon. John Mbadi")
+        assert identifier.has_name("(Dr.) Gichuki Mugo")
+        assert identifier.has_name("The Speaker")
+
+
+class TestSpeakerIntro:
+    """Test suite for speaker introduction parsing."""
+    
+    def test_parse_intro(self, identifier):
+        """Test name, constituency and party are extracted."""
+        assert identifier.parse_speaker_intro("Hon. John Mbadi (Suba South, ODM)") == (
+            "John Mbadi", "Suba South", "ODM"
+        )
+    
+    def test_parse_intro_normalizes_fields(self, identifier):
+        """Test each field is normalized."""
+        assert identifier.parse_speaker_intro("Hon. (Dr.) JANE  DOE (embakasi  CENTRAL constituency, uda):") == (
+            "Jane Doe", "Embakasi Central", "UDA"
+        )
+        assert identifier.parse_speaker_intro("Hon. Peter Kaluma (Homa Bay Town, Ford-k)") == (
+            "Peter Kaluma", "Homa Bay Town", "FORD-K"
+        )
+        assert identifier.parse_speaker_intro("Hon. Mary Emaase (Teso South, Jubilee)") == (
+            "Mary Emaase", "Teso South", "Jubilee"
+        )
+    
+    def test_parse_nominated_member(self, identifier):
+        """Test nominated members keep "Nominated" as their seat."""
+        assert identifier.parse_speaker_intro("Hon. Sabina Chege (Nominated, Jubilee)") == (
+            "Sabina Chege", "Nominated", "Jubilee"
+        )
+    
+    @pytest.mark.parametrize("line", [
+        "Hon. John Mbadi (Suba South):",
+        "Hon. John Mbadi: I rise to support",
+        "Hon. (Dr.) (Suba South, ODM)",
+        "",
+    ])
+    def test_non_intro_lines(self, identifier, line):
+        """Test lines without a full introduction are rejected."""
+        assert identifier.parse_speaker_intro(line) is None
+
+
+class TestTitleCaseName:
+    """Test suite for display title-casing of MP names."""
+    
+    def test_title_case_all_caps(self, identifier):
+        """Test all-caps OCR names are title-cased."""
+        assert identifier.title_case_name("JOHN MBADI") == "John Mbadi"
+    
+    def test_title_case_apostrophe(self, identifier):
+        """Test letters after an internal apostrophe stay lowercase."""
+        assert identifier.title_case_name("JOHN MBADI NG'ONG'O") == "John Mbadi Ng'ong'o"
+        assert identifier.title_case_name("king'ola patrick") == "King'ola Patrick"
+    
+    def test_title_case_particles(self, identifier):
+        """Test name particles stay lowercase inside a name."""
+        assert identifier.title_case_name("KATOO OLE METITO") == "Katoo ole Metito"
+        assert identifier.title_case_name("LEO WA MUTHENDE") == "Leo wa Muthende"
+        assert identifier.title_case_name("DANIEL ARAP MOI") == "Daniel arap Moi"
+    
+    def test_title_case_leading_particle(self, identifier):
+        """Test a particle starting the name is capitalized."""
+        assert identifier.title_case_name("ole Sankok") == "Ole Sankok"
+    
+    def test_title_case_hyphen_and_initials(self, identifier):
+        """Test hyphenated names and dotted initials."""
+        assert identifier.title_case_name("MOSES F.M. WETANG'ULA") == "Moses F.M. Wetang'ula"
+        assert identifier.title_case_name("ANNE WAMUGUNDA-KAMAU") == "Anne Wamugunda-Kamau"
+    
+    def test_title_case_after_normalize(self, identifier):
+        """Test title-casing composes with normalize_mp_name."""
+        normalized = identifier.normalize_mp_name("JOHN MBADI NG'ONG'O (Suba South)")
+        assert identifier.title_case_name(normalized) == "John Mbadi Ng'ong'o"
+
+
+class TestSplitName:
+    """Test suite for splitting names into given names and surname."""
+    
+    def test_split_two_words(self, identifier):
+        """Test a simple given name and surname."""
+        assert identifier.split_name("John Mbadi") == ("John", "Mbadi")
+    
+    def test_split_middle_name(self, identifier):
+        """Test middle names stay with the given names."""
+        assert identifier.split_name("John Mbadi Ng'ong'o") == ("John Mbadi", "Ng'ong'o")
+    
+    def test_split_particle(self, identifier):
+        """Test a particle stays with the surname."""
+        assert identifier.split_name("Katoo ole Metito") == ("Katoo", "ole Metito")
+        assert identifier.split_name("Kabando wa Kabando") == ("Kabando", "wa Kabando")
+        assert identifier.split_name("KATOO OLE METITO") == ("KATOO", "OLE METITO")
+    
+    def test_split_particle_without_given_name(self, identifier):
+        """Test a particle is not mistaken for a given name."""
+        assert identifier.split_name("wa Kabando") == ("", "wa Kabando")
+        assert identifier.split_name("Ole Sankok") == ("", "Ole Sankok")
+    
+    def test_split_apostrophe_surname(self, identifier):
+        """Test names with "Ng'" are not split at the apostrophe."""
+        assert identifier.split_name("Samuel Ng'ang'a") == ("Samuel", "Ng'ang'a")
+    
+    def test_split_comma_form(self, identifier):
+        """Test the parliament website's SURNAME, GIVEN form."""
+        assert identifier.split_name("MEJJADONK, BENJAMIN GATHIRU") == ("BENJAMIN GATHIRU", "MEJJADONK")
+    
+    def test_split_parenthetical_title(self, identifier):
+        """Test parenthetical titles are ignored."""
+        assert identifier.split_name("(DR.) GICHUKI EDWIN MUGO") == ("GICHUKI EDWIN", "MUGO")
+    
+    def test_split_single_word(self, identifier):
+        """Test a single word is treated as the surname."""
+        assert identifier.split_name("Mbadi") == ("", "Mbadi")
+        assert identifier.split_name("") == ("", "")
+    
+    def test_sort_mps_by_surname(self, identifier):
+        """Test MPs are ordered by surname, then given names."""
+        mps = [
+            {'name': 'John Mbadi'},
+            {'name': 'Katoo ole Metito'},
+            {'name': 'Alice Wahome'},
+            {'name': 'Anne Mbadi'},
+            {'name': 'Kabando wa Kabando'},
+            {'name': 'Ruth Wangari'},
+        ]
+        result = identifier.sort_mps_by_surname(mps)
+        assert [mp['name'] for mp in result] == [
+            'Anne Mbadi', 'John Mbadi', 'Katoo ole Metito',
+            'Kabando wa Kabando', 'Alice Wahome', 'Ruth Wangari'
+        ]
+        # Input list is left untouched
+        assert mps[0]['name'] == 'John Mbadi'
 
 
 class TestSpeakerFinding:
@@ -230,6 +415,55 @@ class TestStatementExtraction:
         assert "Statement" in statement
 
 
+class TestStatementOffsets:
+    """Test suite for statement text offsets."""
+    
+    def test_offsets_slice_statement_text(self, identifier):
+        """Test that text_start/text_end slice exactly the statement text."""
+        text = "Hon. John Doe:   First statement.  \n\nHon. Jane Smith: Second statement.\n"
+        statements = identifier.extract_statements(text)
+        
+        assert len(statements) == 2
+        for stmt in statements:
+            assert text[stmt.text_start:stmt.text_end] == stmt.text
+    
+    def test_offsets_exclude_leading_colon(self, identifier):
+        """Test that a leading colon is excluded from the span."""
+        text = "Hon. John: Statement."
+        start, end = identifier.statement_text_span(text, text.index(":"), None)
+        
+        assert text[start:end] == "Statement."
+    
+    def test_span_matches_extract_statement_text(self, identifier):
+        """Test that the span agrees with extract_statement_text."""
+        text = "Hon. John:   Statement text.   Hon. Jane: Next."
+        start_pos = text.index(":")
+        next_pos = text.index("Hon. Jane")
+        
+        start, end = identifier.statement_text_span(text, start_pos, next_pos)
+        
+        assert text[start:end] == identifier.extract_statement_text(text, start_pos, next_pos)
+    
+    def test_empty_statement_span(self, identifier):
+        """Test that a statement with no text has an empty span."""
+        text = "Hon. John:    "
+        start, end = identifier.statement_text_span(text, text.index(":"), None)
+        
+        assert start == end
+    
+    def test_offsets_relative_to_page_text(self, identifier):
+        """Test that offsets index into the page text for page extraction."""
+        pages = [
+            {'page_number': 1, 'text': "Preamble. Hon. John Doe: Page one statement."},
+            {'page_number': 2, 'text': "Hon. Jane Smith: Page two statement."},
+        ]
+        statements = identifier.extract_statements_from_pages(pages)
+        
+        for stmt in statements:
+            page_text = pages[stmt.page_number - 1]['text']
+            assert page_text[stmt.text_start:stmt.text_end] == stmt.text
+
+
 class TestExtractStatements:
     """Test suite for complete statement extraction."""
     
@@ -288,6 +522,13 @@ class TestExtractStatements:
         assert len(statements) == 1
         assert statements[0].mp_name == "Jane Smith"
     
+    def test_skip_title_only_speaker(self, identifier):
+        """Test a speaker label that is only a title is not a speaker."""
+        text = "Hon. Dr: Statement with no speaker name.\nHon. Jane Smith: Real statement here."
+        statements = identifier.extract_statements(text)
+        
+        assert [stmt.mp_name for stmt in statements] == ["Jane Smith"]
+    
     def test_statement_positions(self, identifier):
         """Test that statement positions are recorded."""
         text = "Hon. John Doe: Statement one. Hon. Jane Smith: Statement two."
@@ -313,6 +554,54 @@ class TestExtractStatements:
         assert len(statements) == 0
 
 
+class TestClassifyStatement:
+    """Test suite for statement kinds."""
+    
+    @pytest.mark.parametrize("text, kind", [
+        ("On a point of order, Hon. Speaker. The Member is misleading the House.", STATEMENT_POINT_OF_ORDER),
+        ("Hon. Speaker, I rise on a point of order under Standing Order 95.", STATEMENT_POINT_OF_ORDER),
+        ("I beg to second.", STATEMENT_PROCEDURAL),
+        ("Hon. Speaker, I beg to move.", STATEMENT_PROCEDURAL),
+        ("I beg to reply.", STATEMENT_PROCEDURAL),
+        ("I rise to support this Bill because it protects farmers.", STATEMENT_SUBSTANTIVE),
+        ("That is not a point of order. Proceed, Hon. Member.", STATEMENT_SUBSTANTIVE),
+        ("I was raising a point of order earlier about the lights.", STATEMENT_SUBSTANTIVE),
+    ])
+    def test_phrasing(self, identifier, text, kind):
+        """Test the kind is taken from the contribution's opening words."""
+        assert identifier.classify_statement(text) == kind
+    
+    def test_long_motion_is_substantive(self, identifier):
+        """Test moving a motion with a full speech counts as debate."""
+        text = ("I beg to move that the Finance Bill be now read a Second Time. This Bill "
+                "raises revenue for the counties and removes the tax on bread and flour.")
+        
+        assert identifier.classify_statement(text) == STATEMENT_SUBSTANTIVE
+    
+    def test_late_mention_of_point_of_order(self, identifier):
+        """Test a point of order mentioned deep into a speech does not count."""
+        text = "I support. " + "The roads in my constituency need repair. " * 3 + "That was a point of order."
+        
+        assert identifier.classify_statement(text) == STATEMENT_SUBSTANTIVE
+    
+    def test_section_context(self, identifier):
+        """Test statements are classified by the section they are in."""
+        text = """POINTS OF ORDER
+Hon. John Mbadi: The Cabinet Secretary has not tabled the report as directed.
+PAPERS LAID
+Hon. Aden Duale: The Annual Report of the Kenya Roads Board for 2023 on the Table.
+MOTIONS
+Hon. Alice Wahome: This House should adopt the report without amendment.
+"""
+        statements = identifier.extract_statements(text)
+        
+        assert [s.kind for s in statements] == [
+            STATEMENT_POINT_OF_ORDER,
+            STATEMENT_PROCEDURAL,
+            STATEMENT_SUBSTANTIVE,
+        ]
+
+
 class TestExtractFromPages:
     """Test suite for extracting from PDF pages."""
     
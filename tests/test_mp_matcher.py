@@ -0,0 +1,177 @@
+"""
+Tests for fuzzy MP matching.
+
+This module tests edit-distance scoring and confidence-scored attribution
+of statements to MPs.
+"""
+
+import pytest
+
+from hansard_tales.processors.mp_identifier import Statement
+from hansard_tales.processors.mp_matcher import MPMatcher
+
+
+@pytest.fixture
+def matcher():
+    """Create an MP matcher instance for testing."""
+    return MPMatcher()
+
+
+@pytest.fixture
+def sample_mps():
+    """Create sample MP records."""
+    return [
+        {'id': 1, 'name': 'John Mbadi', 'constituency': 'Suba South', 'party': 'ODM'},
+        {'id': 2, 'name': 'Alice Wahome', 'constituency': 'Kandara', 'party': 'UDA'},
+        {'id': 3, 'name': 'Opiyo Wandayi', 'constituency': 'Ugunja', 'party': 'ODM'},
+    ]
+
+
+class TestLevenshteinDistance:
+    """Test suite for edit distance computation."""
+
+    def test_identical_strings(self):
+        """Test identical strings have zero distance."""
+        assert MPMatcher.levenshtein_distance("mbadi", "mbadi") == 0
+
+    def test_single_substitution(self):
+        """Test a single substituted character."""
+        assert MPMatcher.levenshtein_distance("mbadi", "mbady") == 1
+
+    def test_insertion_and_deletion(self):
+        """Test insertions and deletions are counted."""
+        assert MPMatcher.levenshtein_distance("wandayi", "wandai") == 1
+        assert MPMatcher.levenshtein_distance("", "abc") == 3
+
+
+class TestScoredMatching:
+    """Test suite for confidence-scored statement matching."""
+
+    def test_exact_match_full_confidence(self, matcher, sample_mps):
+        """Test an exact name match scores 1.0."""
+        statement = Statement("John Mbadi", "I rise to support the motion.", 0, 40)
+        mp, confidence = matcher.match_statement_to_mp_scored(statement, sample_mps)
+        assert mp['id'] == 1
+        assert confidence == 1.0
+
+    def test_misspelled_match_lower_confidence(self, matcher, sample_mps):
+        """Test an OCR-mangled name still matches with reduced confidence."""
+        statement = Statement("Alice Wahorne", "Thank you, Mr. Speaker.", 0, 30)
+        mp, confidence = matcher.match_statement_to_mp_scored(statement, sample_mps)
+        assert mp['id'] == 2
+        assert 0.8 <= confidence < 1.0
+
+    def test_unrelated_name_low_confidence(self, matcher, sample_mps):
+        """Test an unknown speaker falls below the default threshold."""
+        statement = Statement("Peter Kaluma", "On a point of order.", 0, 30)
+        _, confidence = matcher.match_statement_to_mp_scored(statement, sample_mps)
+        assert confidence < MPMatcher.DEFAULT_CONFIDENCE_THRESHOLD
+
+    def test_ambiguous_match_penalised(self, matcher):
+        """Test tied candidates without a role label are penalised."""
+        mps = [
+            {'id': 1, 'name': 'John Kamau', 'constituency': 'Lari'},
+            {'id': 2, 'name': 'John Kamau', 'constituency': 'Kiambu'},
+        ]
+        _, confidence = matcher.match_name_scored("John Kamau", mps)
+        assert confidence == pytest.approx(0.5)
+
+    def test_role_label_disambiguates(self, matcher):
+        """Test a constituency role label resolves tied candidates."""
+        mps = [
+            {'id': 1, 'name': 'John Kamau', 'constituency': 'Lari'},
+            {'id': 2, 'name': 'John Kamau', 'constituency': 'Kiambu'},
+        ]
+        mp, confidence = matcher.match_name_scored("John Kamau", mps, role="Kiambu")
+        assert mp['id'] == 2
+        assert confidence == 1.0
+
+    def test_empty_inputs(self, matcher, sample_mps):
+        """Test empty names and MP lists return no match."""
+        assert matcher.match_name_scored("", sample_mps) == (None, 0.0)
+        assert matcher.match_name_scored("Hon. ", sample_mps) == (None, 0.0)
+        assert matcher.match_name_scored("(Dr) ", sample_mps) == (None, 0.0)
+        assert matcher.match_name_scored("John Mbadi", []) == (None, 0.0)
+
+
+class TestPhoneticMatching:
+    """Test suite for phonetic-key matching."""
+
+    @pytest.mark.parametrize("a, b", [
+        ("Opiyo Wandayi", "Opio Wandai"),
+        ("Wandayi", "Whandai"),
+        ("Ng'ang'a", "Nganga"),
+        ("Khalwale", "Kharware"),
+        ("(Dr.) Boni Khalwale", "Boni Khalwale"),
+    ])
+    def test_same_key(self, matcher, a, b):
+        """Test spellings that sound alike share a key."""
+        assert matcher.phonetic_key(a) == matcher.phonetic_key(b)
+
+    def test_key_format(self, matcher):
+        """Test each word is coded as a letter and three digits."""
+        assert matcher.phonetic_key("Opiyo Wandayi") == "O100 W530"
+        assert matcher.phonetic_key("") == ""
+
+    def test_different_names_different_keys(self, matcher):
+        """Test unrelated names do not share a key."""
+        assert matcher.phonetic_key("Alice Wahome") != matcher.phonetic_key("Opiyo Wandayi")
+
+    @pytest.mark.parametrize("mangled, mp_id", [
+        ("Ophio Whandai", 3),
+        ("Jhon Mbbadie", 1),
+    ])
+    def test_fallback_rescues_mangled_names(self, matcher, sample_mps, mangled, mp_id):
+        """Test names edit distance rejects are matched phonetically."""
+        _, edit_confidence = matcher.match_name_scored(mangled, sample_mps)
+        assert edit_confidence < MPMatcher.DEFAULT_CONFIDENCE_THRESHOLD
+
+        mp, confidence = matcher.match_name_scored(mangled, sample_mps, phonetic_fallback=True)
+        assert mp['id'] == mp_id
+        assert confidence == MPMatcher.PHONETIC_MATCH_CONFIDENCE
+
+    def test_fallback_not_used_for_confident_matches(self, matcher, sample_mps):
+        """Test edit distance is preferred when it is already confident."""
+        mp, confidence = matcher.match_name_scored("Alice Wahorne", sample_mps, phonetic_fallback=True)
+        assert mp['id'] == 2
+        assert confidence > MPMatcher.PHONETIC_MATCH_CONFIDENCE
+
+    def test_fallback_without_phonetic_match(self, matcher, sample_mps):
+        """Test an unknown speaker keeps its low edit-distance confidence."""
+        assert matcher.match_name_scored("Peter Kaluma", sample_mps, phonetic_fallback=True) == \
+            matcher.match_name_scored("Peter Kaluma", sample_mps)
+
+
+class TestCollapseSpeakerAliases:
+    """Test suite for collapsing OCR variants of speaker names."""
+
+    def test_reattributes_near_duplicates(self, matcher, sample_mps):
+        """Test misspelled names are rewritten to the MP's name."""
+        statements = [
+            Statement("John Mbadi", "First.", 0, 20),
+            Statement("John Mbadl", "Second.", 20, 40),
+            Statement("Alice Wahorne", "Third.", 40, 60),
+            Statement("John Mbadl", "Fourth.", 60, 80),
+        ]
+
+        collapsed, count = matcher.collapse_speaker_aliases(statements, sample_mps)
+
+        assert [s.mp_name for s in collapsed] == ["John Mbadi", "John Mbadi", "Alice Wahome", "John Mbadi"]
+        assert count == 3
+
+    def test_input_not_modified(self, matcher, sample_mps):
+        """Test the original statements are left untouched."""
+        statements = [Statement("John Mbadl", "Text.", 0, 20)]
+
+        matcher.collapse_speaker_aliases(statements, sample_mps)
+
+        assert statements[0].mp_name == "John Mbadl"
+
+    def test_unmatched_names_kept(self, matcher, sample_mps):
+        """Test names without a confident match are not rewritten."""
+        statements = [Statement("Kimani Ichungwah", "Text.", 0, 20)]
+
+        collapsed, count = matcher.collapse_speaker_aliases(statements, sample_mps)
+
+        assert collapsed == statements
+        assert count == 0
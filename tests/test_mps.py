@@ -0,0 +1,339 @@
+"""
+Tests for MP record utilities.
+"""
+
+import threading
+from datetime import date, datetime
+
+import pytest
+
+from hansard_tales.mps import (
+    DEFAULT_PARTY_COLOR,
+    Defection,
+    MPRepository,
+    active_mps,
+    check_roster_completeness,
+    current_party,
+    detect_defections,
+    diff_mp,
+    find_duplicate_mp_ids,
+    initials_avatar,
+    party_color,
+    reconcile_mps,
+)
+
+
+@pytest.fixture
+def defector():
+    """Create an MP record with a party switch."""
+    return {
+        'name': 'John Doe',
+        'constituency': 'Test Constituency',
+        'party': 'UDA',
+        'party_history': [
+            {'party': 'Jubilee', 'from': '2017-08-31', 'to': '2022-05-20'},
+            {'party': 'UDA', 'from': '2022-05-21'},
+        ],
+    }
+
+
+class TestCurrentParty:
+    """Test suite for party lookup by date."""
+
+    def test_party_before_switch(self, defector):
+        """Test the party held before defecting."""
+        assert current_party(defector, as_of=date(2020, 1, 1)) == 'Jubilee'
+
+    def test_party_after_switch(self, defector):
+        """Test the ongoing tenure after defecting."""
+        assert current_party(defector, as_of=date(2023, 1, 1)) == 'UDA'
+
+    def test_tenure_boundaries_inclusive(self, defector):
+        """Test both ends of a tenure are inclusive."""
+        assert current_party(defector, as_of=date(2022, 5, 20)) == 'Jubilee'
+        assert current_party(defector, as_of=date(2022, 5, 21)) == 'UDA'
+
+    def test_accepts_datetime(self, defector):
+        """Test a datetime reference is reduced to its date."""
+        assert current_party(defector, as_of=datetime(2020, 1, 1, 14, 30)) == 'Jubilee'
+
+    def test_date_not_covered(self, defector):
+        """Test a date before any tenure has no party."""
+        assert current_party(defector, as_of=date(2010, 1, 1)) == ''
+
+    def test_defaults_to_today(self, defector):
+        """Test the current tenure is used when no date is given."""
+        assert current_party(defector) == 'UDA'
+
+    def test_flat_party_without_history(self):
+        """Test records without history use the flat party field."""
+        assert current_party({'name': 'Jane', 'party': 'ODM'}) == 'ODM'
+        assert current_party({'name': 'Jane', 'party': 'ODM', 'party_history': []}) == 'ODM'
+        assert current_party({'name': 'Jane'}) == ''
+
+
+class TestActiveMPs:
+    """Test suite for selecting sitting members."""
+
+    def test_former_members_excluded(self):
+        """Test deceased, resigned and unseated members are dropped."""
+        mps = [
+            {'id': 1, 'membership_status': 'active'},
+            {'id': 2, 'membership_status': 'deceased'},
+            {'id': 3, 'membership_status': 'Resigned'},
+            {'id': 4, 'membership_status': 'unseated'},
+            {'id': 5},
+            {'id': 6, 'membership_status': 'ACTIVE'},
+        ]
+
+        assert [mp['id'] for mp in active_mps(mps)] == [1, 5, 6]
+
+    def test_scraped_records(self):
+        """Test the scraper's elected/nominated status counts as active."""
+        mps = [
+            {'id': 1, 'name': 'John Mbadi', 'status': 'Elected'},
+            {'id': 2, 'name': 'Jane Doe', 'status': 'Nominated'},
+            {'id': 3, 'name': 'Late Member', 'status': 'Elected', 'membership_status': 'deceased'},
+            {'id': 4, 'name': 'No Status', 'status': None},
+        ]
+
+        assert [mp['id'] for mp in active_mps(mps)] == [1, 2, 4]
+
+    def test_empty(self):
+        """Test no MPs gives no active MPs."""
+        assert active_mps([]) == []
+
+
+class TestFindDuplicateMPIds:
+    """Test suite for duplicate MP id detection."""
+
+    def test_duplicates_reported_with_indices(self):
+        """Test every position of a repeated id is listed."""
+        mps = [{'id': 1}, {'id': 2}, {'id': 1}, {'id': 3}, {'id': 2}, {'id': 1}]
+
+        assert find_duplicate_mp_ids(mps) == {1: [0, 2, 5], 2: [1, 4]}
+
+    def test_unique_ids(self):
+        """Test a clean set gives an empty result."""
+        assert find_duplicate_mp_ids([{'id': 1}, {'id': 2}]) == {}
+        assert find_duplicate_mp_ids([]) == {}
+
+    def test_missing_ids_ignored(self):
+        """Test records without an id are not reported as duplicates."""
+        assert find_duplicate_mp_ids([{'name': 'A'}, {'name': 'B', 'id': None}]) == {}
+
+
+class TestCheckRosterCompleteness:
+    """Test suite for roster completeness checks."""
+
+    def test_complete_roster(self):
+        """Test a full National Assembly roster has no gaps."""
+        mps = [{'id': i} for i in range(349)]
+
+        assert check_roster_completeness(mps) == (0, [])
+
+    def test_missing_and_duplicates(self):
+        """Test repeated records and former members do not fill seats."""
+        mps = [{'id': 1}, {'id': 2}, {'id': 1}, {'id': 3, 'membership_status': 'deceased'}, {'id': 2}, {'name': 'No id'}]
+
+        assert check_roster_completeness(mps, expected_seats=5) == (3, [1, 2])
+
+    def test_scraped_roster(self):
+        """Test elected and nominated members from the scraper fill seats."""
+        mps = [{'id': i, 'status': 'Elected'} for i in range(337)]
+        mps += [{'id': 337 + i, 'status': 'Nominated'} for i in range(10)]
+
+        assert check_roster_completeness(mps) == (2, [])
+
+    def test_more_records_than_seats(self):
+        """Test an over-full roster reports no missing seats."""
+        assert check_roster_completeness([{'id': 1}, {'id': 2}], expected_seats=1) == (0, [])
+
+
+class TestDiffMP:
+    """Test suite for field-level MP comparison."""
+
+    def test_changed_fields(self):
+        """Test only differing fields are reported with old and new values."""
+        old = {'id': 1, 'name': 'John Mbadi', 'party': 'ODM', 'constituency': 'Suba South'}
+        new = {'id': 1, 'name': 'John Mbadi', 'party': 'UDA', 'constituency': 'Suba South'}
+
+        assert diff_mp(old, new) == {'party': ('ODM', 'UDA')}
+
+    def test_missing_field_compared_as_none(self):
+        """Test a field present on one side only is a difference."""
+        assert diff_mp({'id': 1}, {'id': 1, 'photo_url': 'https://x/a.jpg'}) == {
+            'photo_url': (None, 'https://x/a.jpg')
+        }
+
+    def test_identical_records(self):
+        """Test identical records have no differences."""
+        assert diff_mp({'id': 1, 'name': 'A'}, {'id': 1, 'name': 'A'}) == {}
+
+
+class TestReconcileMPs:
+    """Test suite for roster reconciliation."""
+
+    def test_by_election(self):
+        """Test a replaced member and a defection are reported."""
+        current = [
+            {'id': 1, 'name': 'John Mbadi', 'party': 'ODM'},
+            {'id': 2, 'name': 'Late Member', 'party': 'UDA'},
+            {'id': 3, 'name': 'Alice Wahome', 'party': 'UDA'},
+        ]
+        incoming = [
+            {'id': 4, 'name': 'New Member', 'party': 'UDA'},
+            {'id': 3, 'name': 'Alice Wahome', 'party': 'UDA'},
+            {'id': 1, 'name': 'John Mbadi', 'party': 'Jubilee'},
+        ]
+
+        added, removed, changed = reconcile_mps(current, incoming)
+
+        assert added == [incoming[0]]
+        assert removed == [current[1]]
+        assert changed == [incoming[2]]
+
+    def test_stable_ordering(self):
+        """Test results follow the order of the input lists."""
+        current = [{'id': 9}, {'id': 8}, {'id': 7}]
+        incoming = [{'id': 3}, {'id': 1}, {'id': 2}]
+
+        added, removed, changed = reconcile_mps(current, incoming)
+
+        assert [mp['id'] for mp in added] == [3, 1, 2]
+        assert [mp['id'] for mp in removed] == [9, 8, 7]
+        assert changed == []
+
+    def test_unchanged_roster(self):
+        """Test an identical roster has no delta."""
+        mps = [{'id': 1, 'name': 'A'}, {'id': 2, 'name': 'B'}]
+
+        assert reconcile_mps(mps, [dict(mp) for mp in mps]) == ([], [], [])
+
+
+class TestDetectDefections:
+    """Test suite for party defection detection."""
+
+    def test_flat_party_change(self):
+        """Test a changed party field is a defection."""
+        old = [{'id': 1, 'party': 'ODM'}, {'id': 2, 'party': 'UDA'}]
+        new = [{'id': 2, 'party': 'UDA'}, {'id': 1, 'party': 'Jubilee'}]
+
+        assert detect_defections(old, new) == [Defection(mp_id=1, from_party='ODM', to_party='Jubilee')]
+
+    def test_party_history_change(self, defector):
+        """Test a new tenure in the party history is a defection."""
+        old = {**defector, 'id': 7, 'party_history': defector['party_history'][:1] + [
+            {'party': 'Jubilee', 'from': '2022-05-21'},
+        ]}
+        new = {**defector, 'id': 7}
+
+        assert detect_defections([old], [new]) == [Defection(mp_id=7, from_party='Jubilee', to_party='UDA')]
+
+    def test_not_defections(self):
+        """Test casing changes, unknown parties and unmatched MPs are ignored."""
+        old = [{'id': 1, 'party': 'odm'}, {'id': 2}, {'id': 3, 'party': 'UDA'}]
+        new = [{'id': 1, 'party': 'ODM '}, {'id': 2, 'party': 'UDA'}, {'id': 4, 'party': 'ODM'}]
+
+        assert detect_defections(old, new) == []
+
+
+class TestMPRepository:
+    """Test suite for the in-memory MP repository."""
+
+    def test_get_and_all(self):
+        """Test MPs can be looked up by id and listed in order."""
+        mps = [{'id': 2, 'name': 'B'}, {'id': 1, 'name': 'A'}, {'name': 'No id'}]
+        repo = MPRepository(mps)
+
+        assert repo.get(1) == {'id': 1, 'name': 'A'}
+        assert repo.get(99) is None
+        assert repo.all() == mps
+        assert repo.all() is not mps
+
+    def test_replace(self):
+        """Test replace swaps the whole set and index."""
+        repo = MPRepository([{'id': 1, 'name': 'A'}])
+
+        repo.replace([{'id': 2, 'name': 'B'}])
+
+        assert repo.get(1) is None
+        assert repo.get(2) == {'id': 2, 'name': 'B'}
+        assert repo.all() == [{'id': 2, 'name': 'B'}]
+
+    def test_empty(self):
+        """Test a new repository holds no MPs."""
+        repo = MPRepository()
+
+        assert repo.all() == []
+        assert repo.get(1) is None
+
+    def test_concurrent_reads_see_complete_sets(self):
+        """Test readers never see a mix of two sets during refreshes."""
+        sets = [[{'id': i, 'batch': batch} for i in range(50)] for batch in range(2)]
+        repo = MPRepository(sets[0])
+        errors = []
+
+        def read():
+            for _ in range(500):
+                batches = {mp['batch'] for mp in repo.all()}
+                if len(batches) != 1:
+                    errors.append(batches)
+
+        readers = [threading.Thread(target=read) for _ in range(4)]
+        for reader in readers:
+            reader.start()
+        for i in range(200):
+            repo.replace(sets[i % 2])
+        for reader in readers:
+            reader.join()
+
+        assert errors == []
+
+
+class TestPartyColor:
+    """Test suite for party colour lookup."""
+
+    def test_known_party(self):
+        """Test parties are looked up ignoring case."""
+        assert party_color('ODM') == '#F57C00'
+        assert party_color('Jubilee') == '#C62828'
+
+    def test_unknown_party(self):
+        """Test independents and unknown parties get the default colour."""
+        assert party_color('Independent') == DEFAULT_PARTY_COLOR
+        assert party_color(None) == DEFAULT_PARTY_COLOR
+
+
+class TestInitialsAvatar:
+    """Test suite for placeholder avatars."""
+
+    def test_initials_and_party_color(self):
+        """Test the avatar shows the initials on the party colour."""
+        svg = initials_avatar({'name': 'John Mbadi', 'party': 'ODM'})
+
+        assert svg.startswith('<svg xmlns="http://www.w3.org/2000/svg"')
+        assert 'fill="#F57C00"' in svg
+        assert '>JM</text>' in svg
+        assert 'aria-label="John Mbadi"' in svg
+
+    def test_titles_and_particles_skipped(self):
+        """Test titles and name particles do not give initials."""
+        assert '>KM</text>' in initials_avatar({'name': 'Hon. (Dr.) Katoo ole Metito'})
+        assert '>K</text>' in initials_avatar({'name': 'wa Kabando'})
+
+    def test_current_party_used(self, defector):
+        """Test the colour follows the MP's current party."""
+        assert 'fill="#FDD835"' in initials_avatar(defector)
+
+    def test_text_contrast(self):
+        """Test light backgrounds get dark text and dark ones white text."""
+        assert 'fill="#212121">JD' in initials_avatar({'name': 'John Doe', 'party': 'UDA'})
+        assert 'fill="#FFFFFF">JD' in initials_avatar({'name': 'John Doe', 'party': 'KANU'})
+
+    def test_deterministic_and_escaped(self):
+        """Test the same record always gives the same, well-formed SVG."""
+        mp = {'name': "Peter O'Brien <Jr>", 'party': 'UDA'}
+
+        assert initials_avatar(mp) == initials_avatar(dict(mp))
+        assert '<Jr>' not in initials_avatar(mp)
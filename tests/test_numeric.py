@@ -0,0 +1,35 @@
+"""
+Tests for floating-point helpers.
+"""
+
+from hansard_tales.numeric import FLOAT_EPSILON, ROUNDING_TOLERANCE, float_equal
+
+
+class TestFloatEqual:
+    """Test suite for tolerant float comparison."""
+
+    def test_rounding_error_is_equal(self):
+        """Test values differing only by rounding error are equal."""
+        assert float_equal(0.1 + 0.2, 0.3)
+        assert float_equal(0.4 * 85.0 + 0.3 * 40.0 + 0.3 * 62.5, 64.75)
+
+    def test_default_epsilon(self):
+        """Test differences beyond the default epsilon are not equal."""
+        assert float_equal(1.0, 1.0 + FLOAT_EPSILON / 2)
+        assert not float_equal(1.0, 1.0 + FLOAT_EPSILON * 10)
+
+    def test_custom_epsilon(self):
+        """Test a wider tolerance is inclusive at its boundary."""
+        assert float_equal(46.0, 46.04, 0.05)
+        assert float_equal(10.0, 10.5, 0.5)
+        assert not float_equal(46.0, 46.1, 0.05)
+
+    def test_rounding_tolerance(self):
+        """Test sums of values rounded to two decimals are equal within the rounding tolerance."""
+        assert float_equal(33.33 + 33.33 + 33.33, 100.0, ROUNDING_TOLERANCE)
+        assert not float_equal(99.9, 100.0, ROUNDING_TOLERANCE)
+
+    def test_nan_never_equal(self):
+        """Test NaN is not equal to anything, including itself."""
+        assert not float_equal(float('nan'), float('nan'))
+        assert not float_equal(float('nan'), 0.0, 1e6)
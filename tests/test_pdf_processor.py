@@ -5,6 +5,7 @@ This module tests the PDF processing functionality including
 text extraction, page handling, and error handling.
 """
 
+import io
 import json
 import tempfile
 from pathlib import Path
@@ -13,7 +14,28 @@ from unittest.mock import Mock, patch, MagicMock
 import pytest
 
 # Import the processor module
-from hansard_tales.processors.pdf_processor import PDFProcessor
+from hansard_tales.processors.pdf_processor import (
+    PDFProcessor,
+    PDFTextExtractor,
+    get_text_extractor,
+    load_session_from_pdf,
+    register_text_extractor,
+)
+
+
+class FakeTextExtractor(PDFTextExtractor):
+    """Extractor returning the stream contents as text."""
+    
+    def extract(self, stream):
+        return stream.read().decode('utf-8')
+
+
+@pytest.fixture
+def fake_extractor():
+    """Register FakeTextExtractor as 'fake' for one test, then unregister it."""
+    with patch.dict('hansard_tales.processors.pdf_processor._TEXT_EXTRACTORS'):
+        register_text_extractor('fake', FakeTextExtractor())
+        yield 'fake'
 
 
 @pytest.fixture
@@ -218,6 +240,48 @@ class TestTextRetrieval:
         assert page_text is None
 
 
+class TestRunningHeader:
+    """Test suite for running header detection and removal."""
+    
+    @pytest.fixture
+    def pages(self):
+        """Create pages with a numbered running header and a cover page."""
+        return [
+            "REPUBLIC OF KENYA\nNATIONAL ASSEMBLY",
+            "Tuesday, 5th March, 2024  NATIONAL ASSEMBLY DEBATES  2\nHon. John Doe: Thank you.",
+            "\n Tuesday, 5th March, 2024  NATIONAL ASSEMBLY DEBATES  3\nHon. Jane Doe: I support.",
+            "Tuesday, 5th March, 2024 NATIONAL ASSEMBLY DEBATES 14\nThe Speaker: Order!",
+        ]
+    
+    def test_detect_header_ignoring_page_numbers(self, processor, pages):
+        """Test headers differing only in page number are detected as one."""
+        header = processor.detect_running_header(pages)
+        
+        assert header == "Tuesday, 5th March, 2024  NATIONAL ASSEMBLY DEBATES  2"
+    
+    def test_no_running_header(self, processor):
+        """Test pages with different first lines have no header."""
+        assert processor.detect_running_header(["Hon. A: Yes.", "Hon. B: No.", "Hon. C: Maybe."]) == ''
+        assert processor.detect_running_header(["Only one page"]) == ''
+        assert processor.detect_running_header([]) == ''
+    
+    def test_strip_header(self, processor, pages):
+        """Test the header is removed from every page that starts with it."""
+        stripped = processor.strip_running_header(pages)
+        
+        assert stripped == [
+            "REPUBLIC OF KENYA\nNATIONAL ASSEMBLY",
+            "Hon. John Doe: Thank you.",
+            "Hon. Jane Doe: I support.",
+            "The Speaker: Order!",
+        ]
+    
+    def test_strip_inspected_header(self, processor, pages):
+        """Test an explicitly given header is used instead of detection."""
+        assert processor.strip_running_header(pages, header="") == pages
+        assert processor.strip_running_header(pages, header="REPUBLIC OF KENYA")[0] == "NATIONAL ASSEMBLY"
+
+
 class TestSaveExtractedText:
     """Test suite for saving extracted text."""
     
@@ -406,6 +470,68 @@ class TestProcessDirectory:
             assert mock_process.call_count == 2
 
 
+class TestTextExtractors:
+    """Test suite for pluggable PDF text extraction."""
+    
+    def test_default_extractor_registered(self):
+        """Test the pdfplumber extractor is the default."""
+        assert isinstance(get_text_extractor(), PDFTextExtractor)
+    
+    def test_null_extractor(self):
+        """Test the stub extractor returns no text."""
+        assert get_text_extractor('null').extract(io.BytesIO(b'%PDF')) == ""
+    
+    def test_register_custom_extractor(self, fake_extractor):
+        """Test a custom extractor can be registered and selected."""
+        assert get_text_extractor(fake_extractor).extract(io.BytesIO(b'Hello')) == 'Hello'
+    
+    def test_register_rejects_non_extractor(self):
+        """Test registering an object without the interface fails."""
+        with pytest.raises(TypeError):
+            register_text_extractor('bad', object())
+    
+    def test_unknown_extractor(self):
+        """Test looking up an unregistered extractor."""
+        with pytest.raises(KeyError, match="nonexistent"):
+            get_text_extractor('nonexistent')
+    
+    @patch('hansard_tales.processors.pdf_processor.pdfplumber')
+    def test_pdfplumber_extractor_joins_pages(self, mock_pdfplumber):
+        """Test the default extractor joins non-empty pages."""
+        pages = [Mock(), Mock(), Mock()]
+        pages[0].extract_text.return_value = 'Page one'
+        pages[1].extract_text.return_value = None
+        pages[2].extract_text.return_value = ' Page three '
+        mock_pdf = MagicMock()
+        mock_pdf.pages = pages
+        mock_pdfplumber.open.return_value.__enter__.return_value = mock_pdf
+        
+        text = get_text_extractor('pdfplumber').extract(io.BytesIO(b'%PDF'))
+        
+        assert text == 'Page one\n\nPage three'
+
+
+class TestLoadSessionFromPDF:
+    """Test suite for building sessions from PDFs."""
+    
+    def test_load_session_with_metadata(self, fake_extractor):
+        """Test metadata and extracted text are combined."""
+        stream = io.BytesIO(b'NATIONAL ASSEMBLY OFFICIAL REPORT Vol. III No. 42')
+        
+        session = load_session_from_pdf(stream, fake_extractor, date='2024-03-05', pdf_url='https://x/a.pdf')
+        
+        assert session['date'] == '2024-03-05'
+        assert session['pdf_url'] == 'https://x/a.pdf'
+        assert session['text'].startswith('NATIONAL ASSEMBLY')
+        assert session['volume'] == 'III'
+        assert session['number'] == '42'
+    
+    def test_load_session_with_null_extractor(self):
+        """Test the stub extractor yields an empty session text."""
+        session = load_session_from_pdf(io.BytesIO(b'%PDF'), 'null', date='2024-03-05')
+        assert session == {'date': '2024-03-05', 'text': ''}
+
+
 class TestRealPDFIntegration:
     """Integration tests using real Hansard PDF sample."""
     
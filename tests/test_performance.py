@@ -0,0 +1,179 @@
+"""
+Tests for MP performance scores.
+"""
+
+import pytest
+
+from hansard_tales.analysis.performance import (
+    PerformanceBreakdown,
+    bills_score,
+    calculate_performance_score,
+    median_performance,
+    performance_breakdown,
+    population_weighted_performance,
+    quality_score,
+    rank_within_party,
+)
+from hansard_tales.processors.mp_identifier import Statement
+
+
+def statement(words):
+    """Build a statement with the given number of words."""
+    return Statement("John Doe", " ".join(["word"] * words), 0, words * 5)
+
+
+class TestCalculatePerformanceScore:
+    """Test suite for the composite performance score."""
+
+    def test_weighted_score(self):
+        """Test components are combined with their weights."""
+        assert calculate_performance_score(80.0, 50.0, 60.0) == pytest.approx(0.4 * 80 + 0.3 * 50 + 0.3 * 60)
+
+    def test_bounds(self):
+        """Test perfect and zero components."""
+        assert calculate_performance_score(100.0, 100.0, 100.0) == pytest.approx(100.0)
+        assert calculate_performance_score(0.0, 0.0, 0.0) == 0.0
+
+    def test_out_of_range_inputs_clamped(self):
+        """Test components outside 0-100 are clamped."""
+        assert calculate_performance_score(150.0, -20.0, 100.0) == pytest.approx(70.0)
+
+
+class TestComponentScores:
+    """Test suite for component score helpers."""
+
+    def test_bills_score(self):
+        """Test the bills score rises linearly and caps at 100."""
+        assert bills_score(0) == 0.0
+        assert bills_score(2) == pytest.approx(40.0)
+        assert bills_score(12) == 100.0
+
+    def test_quality_score(self):
+        """Test the share of substantive statements."""
+        assert quality_score([statement(60), statement(5), statement(50), statement(10)]) == pytest.approx(50.0)
+
+    def test_quality_score_no_statements(self):
+        """Test an MP with no statements scores zero."""
+        assert quality_score([]) == 0.0
+
+
+class TestPopulationWeightedPerformance:
+    """Test suite for population-weighted performance."""
+
+    @pytest.fixture
+    def sample_mps(self):
+        """Create MPs with and without constituencies."""
+        return [
+            {'id': 1, 'constituency': 'EMBAKASI CENTRAL'},
+            {'id': 2, 'constituency': 'Mvita'},
+            {'id': 3, 'constituency': 'Lamu West'},
+            {'id': 4, 'constituency': None},
+        ]
+
+    def test_weighted_by_population(self, sample_mps):
+        """Test larger constituencies count for more."""
+        scores = {1: 80.0, 2: 40.0}
+        population = {'Embakasi Central': 300000, 'Mvita Constituency': 100000}
+
+        assert population_weighted_performance(sample_mps, scores, population) == pytest.approx(70.0)
+
+    def test_missing_population_skipped(self, sample_mps):
+        """Test MPs without population data or a constituency are skipped."""
+        scores = {1: 80.0, 3: 10.0, 4: 0.0}
+        population = {'Embakasi Central': 300000}
+
+        assert population_weighted_performance(sample_mps, scores, population) == pytest.approx(80.0)
+
+    def test_no_usable_data(self, sample_mps):
+        """Test None is returned when nothing can be weighted."""
+        assert population_weighted_performance(sample_mps, {1: 80.0}, {}) is None
+        assert population_weighted_performance([], {}, {}) is None
+
+
+class TestRankWithinParty:
+    """Test suite for intra-party ranking."""
+
+    @pytest.fixture
+    def mps(self):
+        """Create MPs from two parties."""
+        return [
+            {'id': 1, 'party': 'ODM'},
+            {'id': 2, 'party': 'ODM'},
+            {'id': 3, 'party': 'ODM'},
+            {'id': 4, 'party': 'UDA'},
+            {'id': 5, 'party': 'UDA'},
+        ]
+
+    def test_ranked_among_co_partisans(self, mps):
+        """Test ranks restart for each party, best first."""
+        scores = {1: 55.0, 2: 80.0, 3: 70.0, 4: 40.0, 5: 90.0}
+
+        assert rank_within_party(mps, scores) == {2: 1, 3: 2, 1: 3, 5: 1, 4: 2}
+
+    def test_ties_share_rank(self, mps):
+        """Test tied scores share a rank and the next rank is skipped."""
+        scores = {1: 80.0, 2: 80.0, 3: 70.0}
+
+        assert rank_within_party(mps, scores) == {1: 1, 2: 1, 3: 3}
+
+    def test_unscored_and_partyless_omitted(self, mps):
+        """Test MPs without a score or a party are not ranked."""
+        mps.append({'id': 6, 'party': ''})
+        scores = {1: 50.0, 4: 60.0, 6: 99.0}
+
+        assert rank_within_party(mps, scores) == {1: 1, 4: 1}
+
+    def test_rounding_error_ties(self):
+        """Test scores equal but for rounding error share a rank."""
+        mps = [{'id': 1, 'party': 'ODM'}, {'id': 2, 'party': 'ODM'}]
+
+        assert rank_within_party(mps, {1: 0.1 + 0.2, 2: 0.3}) == {1: 1, 2: 1}
+
+    def test_uses_current_party(self):
+        """Test an MP is ranked in the party they currently belong to."""
+        mps = [
+            {'id': 1, 'party': 'ODM'},
+            {'id': 2, 'party_history': [
+                {'party': 'ODM', 'from': '2017-08-31', 'to': '2022-05-20'},
+                {'party': 'UDA', 'from': '2022-05-21'},
+            ]},
+        ]
+
+        assert rank_within_party(mps, {1: 10.0, 2: 90.0}) == {1: 1, 2: 1}
+
+
+class TestPerformanceBreakdown:
+    """Test suite for performance score breakdowns."""
+
+    def test_contributions_sum_to_score(self):
+        """Test the contributions are weighted components summing to the score."""
+        breakdown = performance_breakdown(attendance=85.0, bills=40.0, quality=62.5)
+
+        assert breakdown == PerformanceBreakdown(
+            attendance=pytest.approx(34.0),
+            bills=pytest.approx(12.0),
+            quality=pytest.approx(18.75),
+            total=pytest.approx(calculate_performance_score(85.0, 40.0, 62.5))
+        )
+
+    def test_components_clamped(self):
+        """Test out-of-range components are clamped as in the score."""
+        assert performance_breakdown(attendance=150.0, bills=-5.0, quality=0.0).total == pytest.approx(40.0)
+
+
+class TestMedianPerformance:
+    """Test suite for the median performance score."""
+
+    def test_odd_count(self):
+        """Test the middle score is the median, whatever the outliers."""
+        assert median_performance({1: 40.0, 2: 99.0, 3: 55.0, 4: 0.0, 5: 60.0}) == 55.0
+
+    def test_even_count(self):
+        """Test the two middle scores are averaged."""
+        assert median_performance({1: 70.0, 2: 20.0, 3: 50.0, 4: 90.0}) == 60.0
+        assert median_performance({1: 42.0}) == 42.0
+
+    def test_no_scores(self):
+        """Test an empty mapping is an error."""
+        with pytest.raises(ValueError):
+            median_performance({})
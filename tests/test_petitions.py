@@ -0,0 +1,75 @@
+"""
+Tests for petition response metrics.
+"""
+from datetime import date, timedelta
+
+import pytest
+
+from hansard_tales.analysis.petitions import average_latency, petition_response_latency
+
+
+@pytest.fixture
+def petitions():
+    """Create petitions presented on different dates."""
+    return [
+        {'id': 'P1', 'presented': '2024-02-13'},
+        {'id': 'P2', 'presented': date(2024, 3, 5)},
+        {'id': 'P3', 'presented': '2024-04-10'},
+    ]
+
+
+class TestPetitionResponseLatency:
+    """Test suite for petition response latency."""
+
+    def test_latency_and_pending(self, petitions):
+        """Test answered petitions get a latency and the rest are pending."""
+        responses = [
+            {'petition_id': 'P1', 'date': '2024-04-16'},
+            {'petition_id': 'P2', 'date': date(2024, 3, 19)},
+        ]
+
+        latency, pending = petition_response_latency(petitions, responses)
+
+        assert latency == {'P1': timedelta(days=63), 'P2': timedelta(days=14)}
+        assert pending == ['P3']
+
+    def test_earliest_response_used(self, petitions):
+        """Test a petition answered several times uses its first response."""
+        responses = [
+            {'petition_id': 'P1', 'date': '2024-05-01'},
+            {'petition_id': 'P1', 'date': '2024-02-20'},
+        ]
+
+        latency, _ = petition_response_latency(petitions, responses)
+
+        assert latency['P1'] == timedelta(days=7)
+
+    def test_invalid_responses_ignored(self, petitions):
+        """Test responses predating the petition or for unknown petitions are skipped."""
+        responses = [
+            {'petition_id': 'P3', 'date': '2024-01-01'},
+            {'petition_id': 'P9', 'date': '2024-05-01'},
+        ]
+
+        latency, pending = petition_response_latency(petitions, responses)
+
+        assert latency == {}
+        assert pending == ['P1', 'P2', 'P3']
+
+    def test_same_day_response(self, petitions):
+        """Test a response on the day of presentation has zero latency."""
+        latency, _ = petition_response_latency(petitions, [{'petition_id': 'P3', 'date': '2024-04-10'}])
+
+        assert latency == {'P3': timedelta(0)}
+
+
+class TestAverageLatency:
+    """Test suite for mean petition latency."""
+
+    def test_mean(self):
+        """Test latencies are averaged."""
+        assert average_latency({'P1': timedelta(days=10), 'P2': timedelta(days=20)}) == timedelta(days=15)
+
+    def test_no_answered_petitions(self):
+        """Test there is no average without answered petitions."""
+        assert average_latency({}) is None
@@ -0,0 +1,101 @@
+"""
+Tests for batch session processing.
+"""
+import threading
+import time
+
+import pytest
+
+from hansard_tales.pipeline import BatchProcessingError, process_sessions
+
+
+@pytest.fixture
+def sample_sessions():
+    """Create sample session records."""
+    return [
+        {'date': f'2024-03-{day:02d}', 'title': f'Sitting {day}'}
+        for day in range(1, 11)
+    ]
+
+
+class TestProcessSessions:
+    """Test suite for process_sessions."""
+
+    def test_processes_every_session(self, sample_sessions):
+        """Test fn is called once per session."""
+        seen = []
+        lock = threading.Lock()
+
+        def fn(cancel_event, session):
+            with lock:
+                seen.append(session['date'])
+
+        process_sessions(sample_sessions, fn, max_workers=3)
+        assert sorted(seen) == [s['date'] for s in sample_sessions]
+
+    def test_concurrency_is_bounded(self, sample_sessions):
+        """Test no more than max_workers sessions run at once."""
+        active = 0
+        peak = 0
+        lock = threading.Lock()
+
+        def fn(cancel_event, session):
+            nonlocal active, peak
+            with lock:
+                active += 1
+                peak = max(peak, active)
+            time.sleep(0.01)
+            with lock:
+                active -= 1
+
+        process_sessions(sample_sessions, fn, max_workers=2)
+        assert peak <= 2
+
+    def test_errors_are_aggregated(self, sample_sessions):
+        """Test every failing session is reported, not just the first."""
+        def fn(cancel_event, session):
+            if session['date'].endswith(('03', '07')):
+                raise ValueError(f"bad PDF for {session['date']}")
+
+        with pytest.raises(BatchProcessingError) as exc_info:
+            process_sessions(sample_sessions, fn, max_workers=4)
+
+        failed = sorted(session['date'] for session, _ in exc_info.value.errors)
+        assert failed == ['2024-03-03', '2024-03-07']
+        assert not exc_info.value.cancelled
+
+    def test_cancellation_stops_new_work(self, sample_sessions):
+        """Test no new sessions start once the run is cancelled."""
+        cancel = threading.Event()
+        started = []
+
+        def fn(cancel_event, session):
+            started.append(session['date'])
+            if len(started) == 2:
+                cancel_event.set()
+
+        with pytest.raises(BatchProcessingError) as exc_info:
+            process_sessions(sample_sessions, fn, max_workers=1, cancel_event=cancel)
+
+        assert exc_info.value.cancelled
+        assert len(started) == 2
+
+    def test_already_cancelled(self, sample_sessions):
+        """Test a pre-cancelled run starts nothing."""
+        cancel = threading.Event()
+        cancel.set()
+        calls = []
+
+        with pytest.raises(BatchProcessingError):
+            process_sessions(sample_sessions, lambda c, s: calls.append(s), cancel_event=cancel)
+
+        assert calls == []
+
+    def test_empty_sessions(self):
+        """Test an empty batch succeeds without calling fn."""
+        process_sessions([], lambda c, s: pytest.fail("should not be called"))
+
+    def test_invalid_worker_count(self, sample_sessions):
+        """Test a non-positive worker count is rejected."""
+        with pytest.raises(ValueError):
+            process_sessions(sample_sessions, lambda c, s: None, max_workers=0)
@@ -0,0 +1,83 @@
+"""
+Tests for per-MP report building.
+"""
+
+import pytest
+
+from hansard_tales.analysis.performance import calculate_performance_score
+from hansard_tales.analysis.reports import MPReport, build_mp_reports
+from hansard_tales.analysis.speech_metrics import SpeechStats
+from hansard_tales.processors.mp_identifier import Statement
+
+
+@pytest.fixture
+def sample_mps():
+    """Create sample MP records."""
+    return [
+        {'id': 1, 'name': 'John Doe', 'party': 'ODM'},
+        {'id': 2, 'name': 'Jane Smith', 'party': 'UDA'},
+    ]
+
+
+@pytest.fixture
+def sample_statements():
+    """Create statements keyed by MP id."""
+    long_text = "Healthcare funding in our hospitals " * 12
+    return {
+        1: [
+            Statement("John Doe", long_text.strip(), 0, 500),
+            Statement("John Doe", "Hospitals need doctors. (Applause)", 500, 540),
+        ],
+    }
+
+
+@pytest.fixture
+def sample_bills():
+    """Create bills sponsored by the first MP."""
+    return [
+        {'id': 'Health Bill 2024', 'sponsor_mp_id': 1},
+        {'id': 'Finance Bill 2024', 'sponsor_mp_id': 1},
+        {'id': 'Senate Bill 2024', 'sponsor_mp_id': None},
+    ]
+
+
+class TestBuildMPReports:
+    """Test suite for building MP reports."""
+
+    def test_report_per_mp_in_order(self, sample_mps, sample_statements, sample_bills):
+        """Test one report is built per MP, in input order."""
+        reports = build_mp_reports(sample_mps, sample_statements, sample_bills, {1: 90.0, 2: 70.0})
+
+        assert [report.mp['id'] for report in reports] == [1, 2]
+
+    def test_metrics_combined(self, sample_mps, sample_statements, sample_bills):
+        """Test statistics, bills, topics and score are filled in."""
+        report = build_mp_reports(sample_mps, sample_statements, sample_bills, {1: 90.0})[0]
+
+        assert report.stats == SpeechStats(statement_count=2, word_count=63)
+        assert report.bills_sponsored == 2
+        assert report.top_topics[:2] == ["hospitals", "funding"]
+        assert report.score == pytest.approx(calculate_performance_score(90.0, 40.0, 50.0))
+
+    def test_name_variants_combined(self, sample_mps):
+        """Test an MP's statements under different spellings are counted together."""
+        statements = {
+            1: [
+                Statement("John Doe", "I support the Bill.", 0, 20),
+                Statement("J. Doe", "Thank you. (Applause)", 20, 40),
+            ],
+        }
+
+        report = build_mp_reports(sample_mps, statements, [], {})[0]
+
+        assert report.stats == SpeechStats(statement_count=2, word_count=6)
+
+    def test_mp_without_data(self, sample_mps):
+        """Test an MP with no statements, bills or attendance."""
+        report = build_mp_reports(sample_mps, {}, [], {})[1]
+
+        assert report == MPReport(mp=sample_mps[1], score=0.0, stats=SpeechStats())
+
+    def test_no_mps(self):
+        """Test no MPs give no reports."""
+        assert build_mp_reports([], {}, [], {}) == []
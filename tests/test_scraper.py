@@ -13,6 +13,7 @@ import pytest
 
 # Import the scraper module
 from hansard_tales.scrapers.hansard_scraper import HansardScraper
+from hansard_tales.dates import InvalidDateError
 
 
 @pytest.fixture
@@ -55,6 +56,12 @@ class TestDateExtraction:
         date = scraper.extract_date(text)
         assert date == "2024-03-15"
     
+    def test_extract_date_ordinal_day_first(self, scraper):
+        """Test extracting a day-first date with an ordinal suffix."""
+        text = "Hansard Report - Tuesday, 5th March 2024"
+        date = scraper.extract_date(text)
+        assert date == "2024-03-05"
+    
     def test_extract_date_single_digit_day(self, scraper):
         """Test extracting date with single digit day."""
         text = "Hansard 5/3/2024"
@@ -67,6 +74,11 @@ class TestDateExtraction:
         date = scraper.extract_date(text)
         assert date is None
     
+    def test_extract_date_invalid_date(self, scraper):
+        """Test a date-like string that is not a real date raises."""
+        with pytest.raises(InvalidDateError, match="2025-13-45"):
+            scraper.extract_date("Hansard 2025-13-45")
+    
     def test_extract_date_multiple_dates(self, scraper):
         """Test extracting first date when multiple dates present."""
         text = "Hansard 15/03/2024 and 16/03/2024"
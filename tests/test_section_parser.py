@@ -0,0 +1,551 @@
+"""
+Tests for order-of-business section parsing.
+"""
+
+import pytest
+
+from hansard_tales.processors.mp_identifier import HEADING_PATTERN
+from hansard_tales.processors.section_parser import (
+    AdjournmentDebate,
+    CommitteeReport,
+    Communication,
+    MinisterialStatement,
+    Motion,
+    Notice,
+    QUESTION_ORAL,
+    QUESTION_PRIVATE_NOTICE,
+    QUESTION_WRITTEN,
+    SectionParser,
+    Supplementary,
+    TOCEntry,
+    normalize_ministry,
+)
+
+
+@pytest.fixture
+def parser():
+    """Create a section parser instance for testing."""
+    return SectionParser()
+
+
+@pytest.fixture
+def sample_sitting():
+    """Create sample Hansard text with several sections."""
+    return """
+PRAYERS
+Hon. Speaker: Order, Members.
+
+MOTIONS
+ADOPTION OF REPORT ON THE BUDGET ESTIMATES
+THAT, this House adopts the Report of the Budget and Appropriations
+Committee on the Budget Estimates for the 2023/2024 financial year.
+(Hon. NDINDI NYORO) seconded by (Hon. Mary Emaase)
+
+EXTENSION OF SITTING HOURS
+THAT, this House resolves to extend its sitting hours on Thursday.
+(Hon. Kimani Ichung'wah)
+
+BILLS
+THAT, the Finance Bill be now read a Second Time.
+"""
+
+
+class TestExtractSection:
+    """Test suite for locating sections."""
+
+    def test_extract_section_body(self, parser, sample_sitting):
+        """Test a section runs to the next top-level heading."""
+        section = parser.extract_section(sample_sitting, "Motions")
+
+        assert section.startswith("ADOPTION OF REPORT")
+        assert "EXTENSION OF SITTING HOURS" in section
+        assert "Finance Bill" not in section
+
+    def test_missing_section(self, parser, sample_sitting):
+        """Test a heading that does not appear."""
+        assert parser.extract_section(sample_sitting, "PETITIONS") is None
+        assert parser.extract_section("", "MOTIONS") is None
+
+
+class TestHeadingPositions:
+    """Test suite for item-of-business boundaries."""
+
+    def test_every_heading_found(self, parser):
+        """Test section and business-item headings are both boundaries."""
+        text = "MOTIONS\nADOPTION OF REPORT\nHon. John Doe: I beg to move.\nBILLS\n"
+
+        assert parser.heading_positions(text) == [
+            0, text.index("ADOPTION"), text.index("BILLS")
+        ]
+
+    def test_bill_title_with_year(self, parser):
+        """Test a Bill's title is a boundary but not a section heading."""
+        text = "BILLS\nTHE FINANCE BILL, 2024\nHon. John Doe: I beg to move.\n"
+
+        assert parser.heading_positions(text) == [0, text.index("THE FINANCE")]
+        assert parser.extract_section(text, "BILLS") is not None
+        assert HEADING_PATTERN.search(text, 1) is None
+
+    def test_no_headings(self, parser):
+        """Test text without headings has no boundaries."""
+        assert parser.heading_positions("Hon. John Doe: Thank you.") == []
+        assert parser.heading_positions("") == []
+
+
+class TestParseMotions:
+    """Test suite for motion extraction."""
+
+    def test_mover_and_seconder(self, parser, sample_sitting):
+        """Test the mover and seconder are extracted and normalized."""
+        motions = parser.parse_motions(sample_sitting)
+
+        assert motions[0] == Motion(
+            text="THAT, this House adopts the Report of the Budget and Appropriations "
+                 "Committee on the Budget Estimates for the 2023/2024 financial year.",
+            mover="Ndindi Nyoro",
+            seconder="Mary Emaase"
+        )
+
+    def test_motion_without_seconder(self, parser, sample_sitting):
+        """Test a motion with no recorded seconder has an empty seconder."""
+        motions = parser.parse_motions(sample_sitting)
+
+        assert len(motions) == 2
+        assert motions[1].mover == "Kimani Ichung'wah"
+        assert motions[1].seconder == ""
+        assert motions[1].text == "THAT, this House resolves to extend its sitting hours on Thursday."
+
+    def test_unparenthesised_attribution(self, parser):
+        """Test "Moved by Hon. X, seconded by Hon. Y" without parentheses."""
+        text = "THAT, this House notes the report.\nMoved by Hon. John Mbadi, seconded by Hon. Aden Duale\n"
+        motions = parser.parse_motions(text)
+
+        assert motions[0].mover == "John Mbadi"
+        assert motions[0].seconder == "Aden Duale"
+        assert motions[0].text == "THAT, this House notes the report."
+
+    def test_motion_without_attribution(self, parser):
+        """Test a motion with no mover recorded."""
+        motions = parser.parse_motions("THAT, this House notes the report.")
+
+        assert motions == [Motion(text="THAT, this House notes the report.")]
+
+    def test_no_motions(self, parser):
+        """Test text with no motions."""
+        assert parser.parse_motions("Hon. John Doe: Thank you.") == []
+        assert parser.parse_motions("") == []
+
+
+class TestParseMinisterialStatements:
+    """Test suite for Cabinet Secretary statement extraction."""
+
+    def test_statements_section(self, parser):
+        """Test statements are taken from the STATEMENTS section."""
+        text = """
+STATEMENTS
+The Cabinet Secretary for Health (Hon. Susan Nakhumicha): Hon. Speaker,
+the Ministry has procured new equipment.
+Hon. John Mbadi: When will it be delivered?
+The Cabinet Secretary, Ministry of Interior and National Administration (Hon. (Prof.) Kithure Kindiki): Security has improved.
+
+MOTIONS
+The Cabinet Secretary for Treasury (Hon. Njuguna Ndung'u): Not a statement.
+"""
+        statements = parser.parse_ministerial_statements(text)
+
+        assert statements == [
+            MinisterialStatement(
+                ministry="Health",
+                secretary="Susan Nakhumicha",
+                text="Hon. Speaker,\nthe Ministry has procured new equipment."
+            ),
+            MinisterialStatement(
+                ministry="Interior and National Administration",
+                secretary="Kithure Kindiki",
+                text="Security has improved."
+            ),
+        ]
+
+    def test_without_section_heading(self, parser):
+        """Test text without a STATEMENTS heading is searched whole."""
+        text = "The Cabinet Secretary for the National Treasury (Hon. NJUGUNA NDUNG'U): The shilling is stable."
+        statements = parser.parse_ministerial_statements(text)
+
+        assert len(statements) == 1
+        assert statements[0].ministry == "National Treasury"
+        assert statements[0].secretary == "Njuguna Ndung'u"
+
+    def test_ministry_variants_canonicalized(self, parser):
+        """Test older ministry names are reported under the current name."""
+        text = """The Cabinet Secretary for the National Treasury and Planning (Hon. Ukur Yatani): The budget is ready.
+The Cabinet Secretary, Ministry of Interior and Coordination of National Government (Hon. Fred Matiang'i): Calm has returned."""
+        statements = parser.parse_ministerial_statements(text)
+
+        assert [statement.ministry for statement in statements] == [
+            "National Treasury",
+            "Interior and National Administration",
+        ]
+
+    def test_no_ministerial_statements(self, parser):
+        """Test text without Cabinet Secretaries."""
+        assert parser.parse_ministerial_statements("Hon. John Doe: Thank you.") == []
+        assert parser.parse_ministerial_statements("") == []
+
+
+class TestParseNoticesOfMotion:
+    """Test suite for notice of motion extraction."""
+
+    def test_notices_with_titles(self, parser):
+        """Test the printed title is used as the subject."""
+        text = """
+NOTICES OF MOTION
+ESTABLISHMENT OF A NATIONAL DISASTER FUND
+Hon. John Mbadi (Suba South, ODM): Hon. Speaker, I beg to give notice of the following Motion:
+THAT, aware that floods have displaced thousands of families.
+REGULATION OF BODA BODA OPERATORS
+Hon. Alice Wahome: Hon. Speaker, I beg to give notice of the following Motion:
+THAT, this House urges the Government to regulate boda boda operators.
+The Speaker: Next Order.
+
+MOTIONS
+Hon. Opiyo Wandayi: I beg to give notice of nothing here.
+"""
+        notices = parser.parse_notices_of_motion(text)
+
+        assert notices == [
+            Notice(sponsor="John Mbadi", subject="ESTABLISHMENT OF A NATIONAL DISASTER FUND"),
+            Notice(sponsor="Alice Wahome", subject="REGULATION OF BODA BODA OPERATORS"),
+        ]
+
+    def test_notice_without_title(self, parser):
+        """Test the motion text is used when no title is printed."""
+        text = "Hon. John Mbadi: I beg to give notice of the following Motion:\nTHAT, this House adopts the report."
+        notices = parser.parse_notices_of_motion(text)
+
+        assert notices == [Notice(sponsor="John Mbadi", subject="THAT, this House adopts the report.")]
+
+    def test_other_speakers_ignored(self, parser):
+        """Test statements that do not give notice are skipped."""
+        text = "NOTICES OF MOTION\nThe Speaker: Give notice now.\nHon. John Mbadi: Thank you."
+
+        assert parser.parse_notices_of_motion(text) == []
+        assert parser.parse_notices_of_motion("") == []
+
+
+class TestParseQuestions:
+    """Test suite for question extraction."""
+
+    @pytest.fixture
+    def question_time(self):
+        """Create a questions section with each question type."""
+        return """
+QUESTIONS AND STATEMENTS
+ORAL ANSWERS TO QUESTIONS
+Question No.045/2023
+STATUS OF KISUMU-BUSIA ROAD
+Hon. John Mbadi (Suba South, ODM) asked the Cabinet Secretary for Roads:
+When will construction resume?
+The Speaker: Cabinet Secretary.
+The Cabinet Secretary for Roads (Hon. Kipchumba Murkomen): Construction resumes in June.
+Hon. John Mbadi: Thank you.
+WRITTEN ANSWERS TO QUESTIONS
+Question No.12
+Hon. Alice Wahome asked the Cabinet Secretary for Water how many dams are planned.
+QUESTION BY PRIVATE NOTICE
+Question No.3
+Hon. Opiyo Wandayi asked the Cabinet Secretary for Interior:
+What caused the flooding?
+Hon. Aden Duale: The matter is under investigation.
+
+MOTIONS
+Hon. Aden Duale: This is not an answer.
+"""
+
+    def test_question_types(self, parser, question_time):
+        """Test each question is tagged with its subsection's type."""
+        questions = parser.parse_questions(question_time)
+
+        assert [(q.number, q.question_type) for q in questions] == [
+            ("045/2023", QUESTION_ORAL),
+            ("12", QUESTION_WRITTEN),
+            ("3", QUESTION_PRIVATE_NOTICE),
+        ]
+
+    def test_asker_question_and_answer(self, parser, question_time):
+        """Test the asker, question text and answer are extracted."""
+        oral, written, private = parser.parse_questions(question_time)
+
+        assert oral.asker == "John Mbadi"
+        assert oral.text == "the Cabinet Secretary for Roads: When will construction resume?"
+        assert oral.answer == "Construction resumes in June."
+        assert written.asker == "Alice Wahome"
+        assert written.answer == ""
+        assert private.answer == "The matter is under investigation."
+
+    def test_untyped_questions_default_to_oral(self, parser):
+        """Test questions before any subsection heading are oral."""
+        questions = parser.parse_questions("Question No.7\nHon. John Doe asked the Cabinet Secretary.")
+
+        assert len(questions) == 1
+        assert questions[0].question_type == QUESTION_ORAL
+        assert questions[0].asker == "John Doe"
+
+    def test_no_questions(self, parser):
+        """Test text without numbered questions."""
+        assert parser.parse_questions("Hon. John Doe: Thank you.") == []
+        assert parser.parse_questions("") == []
+
+    def test_supplementaries(self, parser):
+        """Test follow-up questions after the answer are linked to their question."""
+        text = """
+ORAL ANSWERS TO QUESTIONS
+Question No.045/2023
+Hon. John Mbadi (Suba South, ODM) asked the Cabinet Secretary for Roads:
+When will construction resume?
+The Cabinet Secretary for Roads (Hon. Kipchumba Murkomen): Construction resumes in June.
+The Speaker: Hon. Mbadi.
+Hon. John Mbadi: Thank you, Hon. Speaker. Has the contractor been paid?
+The Cabinet Secretary for Roads (Hon. Kipchumba Murkomen): Yes, in full.
+Hon. Ruth Odinga: I have a supplementary on the Busia section.
+Hon. Aden Duale: Thank you.
+Question No.46
+Hon. Alice Wahome asked the Cabinet Secretary for Water how many dams are planned.
+Hon. Ruth Odinga: When will Kandara get one?
+"""
+        assert parser.parse_supplementaries(text) == [
+            Supplementary(
+                question_number="045/2023",
+                asker="John Mbadi",
+                text="Thank you, Hon. Speaker. Has the contractor been paid?"
+            ),
+            Supplementary(
+                question_number="045/2023",
+                asker="Ruth Odinga",
+                text="I have a supplementary on the Busia section."
+            ),
+        ]
+
+    def test_no_supplementaries(self, parser, question_time):
+        """Test remarks after an answer are not supplementaries."""
+        assert parser.parse_supplementaries(question_time) == []
+        assert parser.parse_supplementaries("") == []
+
+
+class TestParseTableOfContents:
+    """Test suite for table of contents extraction."""
+
+    def test_dotted_leaders(self, parser):
+        """Test titles and page numbers separated by dotted leaders."""
+        text = """NATIONAL ASSEMBLY
+CONTENTS
+Prayers ..................................... 1
+Papers Laid. . . . . . . . . . . . . . . . . .2
+Notices of Motion ........................... 4
+Motion: Adoption of Report on the Finance Bill, 2024 ........ 12
+
+Hon. Speaker: Order!
+"""
+        entries = parser.parse_table_of_contents(text)
+
+        assert entries == [
+            TOCEntry(title="Prayers", page=1),
+            TOCEntry(title="Papers Laid", page=2),
+            TOCEntry(title="Notices of Motion", page=4),
+            TOCEntry(title="Motion: Adoption of Report on the Finance Bill, 2024", page=12),
+        ]
+
+    def test_wrapped_title_and_spaced_columns(self, parser):
+        """Test wrapped titles are joined and space-aligned numbers are read."""
+        text = """TABLE OF CONTENTS
+Statement on the Status of Road Construction in
+Kisumu County ...................... 7
+Adjournment                          15
+"""
+        entries = parser.parse_table_of_contents(text)
+
+        assert entries == [
+            TOCEntry(title="Statement on the Status of Road Construction in Kisumu County", page=7),
+            TOCEntry(title="Adjournment", page=15),
+        ]
+
+    def test_no_contents(self, parser):
+        """Test reports without a contents page."""
+        assert parser.parse_table_of_contents("PRAYERS\nHon. John Doe: Thank you.") == []
+        assert parser.parse_table_of_contents("") == []
+
+
+class TestParseCommitteeReports:
+    """Test suite for committee reports laid on the Table."""
+
+    @pytest.fixture
+    def papers(self):
+        """Create a PAPERS LAID section with committee and other papers."""
+        return """
+PAPERS LAID
+Hon. Owen Baya: Hon. Speaker, I beg to lay the following Papers on the Table of the House:
+The Annual Report and Financial Statements of the Kenya Ports Authority for the year ended 30th June 2023.
+Report of the Auditor-General on the Financial Statements of the Equalization Fund.
+Report of the Departmental Committee on Health on its consideration of the Quality
+Healthcare and Patient Safety Bill, 2023.
+Report of the Public Accounts Committee on the Audited Accounts for 2021/2022.
+(Placed on the Table)
+Hon. Kuria Kimani: I beg to lay the Report of the Departmental Committee on Finance and
+National Planning on the Finance Bill, 2024 on the Table of the House.
+(Placed on the Table)
+
+MOTIONS
+THAT, this House adopts the Report of the Budget and Appropriations Committee on the Estimates.
+"""
+
+    def test_reports_in_papers_laid(self, parser, papers):
+        """Test committee and title are read from each committee report."""
+        reports = parser.parse_committee_reports(papers)
+
+        assert reports == [
+            CommitteeReport(
+                committee="Departmental Committee on Health",
+                title="Report of the Departmental Committee on Health on its consideration "
+                      "of the Quality Healthcare and Patient Safety Bill, 2023"
+            ),
+            CommitteeReport(
+                committee="Public Accounts Committee",
+                title="Report of the Public Accounts Committee on the Audited Accounts for 2021/2022"
+            ),
+            CommitteeReport(
+                committee="Departmental Committee on Finance and National Planning",
+                title="Report of the Departmental Committee on Finance and National Planning "
+                      "on the Finance Bill, 2024"
+            ),
+        ]
+
+    def test_linked_to_committees(self, parser, papers):
+        """Test reports are linked to known committees by name."""
+        committees = [
+            {'id': 7, 'name': 'The Public Accounts Committee'},
+            {'id': 3, 'name': 'DEPARTMENTAL COMMITTEE ON HEALTH'},
+        ]
+
+        reports = parser.parse_committee_reports(papers, committees)
+
+        assert [report.committee_id for report in reports] == [3, 7, None]
+
+    def test_no_reports(self, parser):
+        """Test text without committee reports."""
+        assert parser.parse_committee_reports("PRAYERS\nHon. John Doe: Thank you.") == []
+        assert parser.parse_committee_reports("") == []
+
+
+class TestNormalizeMinistry:
+    """Test suite for ministry name normalization."""
+
+    @pytest.mark.parametrize("raw", [
+        "Ministry of Health",
+        "Health Ministry",
+        "Cabinet Secretary for Health",
+        "The Cabinet Secretary, Ministry of Health",
+        "  HEALTH ",
+        "Ministry of Medical Services",
+    ])
+    def test_variants(self, raw):
+        """Test role labels and renamed ministries map to one name."""
+        assert normalize_ministry(raw) == "Health"
+
+    def test_unknown_ministry_title_cased(self):
+        """Test ministries missing from the table are title-cased."""
+        assert normalize_ministry("ministry of water, sanitation and irrigation") == "Water, Sanitation and Irrigation"
+
+    def test_empty(self):
+        """Test empty input gives an empty name."""
+        assert normalize_ministry("") == ""
+        assert normalize_ministry(None) == ""
+
+
+class TestParseAdjournmentDebate:
+    """Test suite for adjournment debate extraction."""
+
+    def test_titled_debate(self, parser):
+        """Test the title under the heading is the topic."""
+        text = """
+MOTIONS
+THAT, this House adopts the Report.
+(Hon. John Mbadi)
+
+ADJOURNMENT MOTION
+INSECURITY IN KERIO VALLEY
+The Speaker: Hon. Members, the Member for Tiaty has the Floor.
+Hon. William Kamket: Thank you, Hon. Speaker. Bandits attacked Kolowa on Sunday.
+Hon. Aden Duale: I support.
+
+ADJOURNMENT
+"""
+        assert parser.parse_adjournment_debate(text) == AdjournmentDebate(
+            mp="William Kamket",
+            topic="INSECURITY IN KERIO VALLEY"
+        )
+
+    def test_topic_from_namely(self, parser):
+        """Test the mover's "namely" clause is used when there is no title."""
+        text = """MOTION FOR ADJOURNMENT UNDER STANDING ORDER 33
+Hon. Ruth Odinga: Hon. Speaker, I beg to move that the House do now adjourn to discuss
+a definite matter of urgent national importance, namely, the flooding of homes
+in Nyando Constituency. Many families have been displaced.
+Hon. Aden Duale: I second.
+"""
+        assert parser.parse_adjournment_debate(text) == AdjournmentDebate(
+            mp="Ruth Odinga",
+            topic="the flooding of homes in Nyando Constituency"
+        )
+
+    def test_no_adjournment_debate(self, parser, sample_sitting):
+        """Test sittings without an adjournment debate."""
+        assert parser.parse_adjournment_debate(sample_sitting) is None
+        assert parser.parse_adjournment_debate("ADJOURNMENT\nThe House rose at 6.30 p.m.") is None
+        assert parser.parse_adjournment_debate("") is None
+
+
+class TestParseChairCommunications:
+    """Test suite for communications from the Chair."""
+
+    def test_multiple_communications(self, parser):
+        """Test each titled communication is extracted with the Chair's words only."""
+        text = """
+PRAYERS
+
+COMMUNICATION FROM THE CHAIR
+VISITING DELEGATION FROM THE PARLIAMENT OF UGANDA
+The Speaker (Hon. Moses Wetang'ula): Hon. Members, I wish to acknowledge
+a delegation from the Parliament of Uganda seated in the Speaker's Gallery.
+RULING ON THE ADMISSIBILITY OF AMENDMENTS
+Hon. Speaker: Hon. Members, amendments to a Money Bill that were not
+considered by the Budget Committee are out of order.
+Hon. John Mbadi: On a point of order, Hon. Speaker.
+
+PETITIONS
+Hon. Ruth Odinga: I beg to present a petition.
+"""
+        assert parser.parse_chair_communications(text) == [
+            Communication(
+                subject="VISITING DELEGATION FROM THE PARLIAMENT OF UGANDA",
+                text="Hon. Members, I wish to acknowledge a delegation from the "
+                     "Parliament of Uganda seated in the Speaker's Gallery."
+            ),
+            Communication(
+                subject="RULING ON THE ADMISSIBILITY OF AMENDMENTS",
+                text="Hon. Members, amendments to a Money Bill that were not "
+                     "considered by the Budget Committee are out of order."
+            ),
+        ]
+
+    def test_untitled_communication(self, parser):
+        """Test a communication without a title has an empty subject."""
+        text = """COMMUNICATIONS FROM THE CHAIR
+The Temporary Speaker (Hon. Jane Doe): Hon. Members, the Liaison Committee will meet at 10.00 a.m.
+"""
+        assert parser.parse_chair_communications(text) == [
+            Communication(subject="", text="Hon. Members, the Liaison Committee will meet at 10.00 a.m.")
+        ]
+
+    def test_no_communications(self, parser, sample_sitting):
+        """Test sittings without a communication section."""
+        assert parser.parse_chair_communications(sample_sitting) == []
+        assert parser.parse_chair_communications("") == []
@@ -0,0 +1,330 @@
+"""
+Tests for session record utilities.
+"""
+
+from datetime import date, datetime, timedelta, timezone
+
+import pytest
+
+from hansard_tales.sessions import (
+    SessionSummary,
+    compress_transcript,
+    decompress_transcript,
+    dedupe_sessions,
+    group_sessions_by_day,
+    group_sessions_by_month,
+    normalize_session_title,
+    rolling_sitting_count,
+    session_fingerprint,
+    sitting_day,
+    split_compiled_hansard,
+    summarize_session,
+)
+
+
+@pytest.fixture
+def mirrored_sessions():
+    """Create records of the same sitting from two mirrors plus another sitting."""
+    return [
+        {
+            'date': '2024-03-05',
+            'title': 'Hansard Report - Tuesday, 5th March 2024 (P)',
+            'url': 'https://parliament.go.ke/a.pdf',
+        },
+        {
+            'date': '2024-03-05',
+            'title': 'HANSARD REPORT  Tuesday 5th March 2024 (P)',
+            'url': 'https://mirror.example.org/b.pdf',
+            'filename': 'b.pdf',
+        },
+        {
+            'date': '2024-03-06',
+            'title': 'Hansard Report - Wednesday, 6th March 2024 (A)',
+            'url': 'https://parliament.go.ke/c.pdf',
+        },
+    ]
+
+
+class TestSessionFingerprint:
+    """Test suite for session fingerprints."""
+
+    def test_normalize_title(self):
+        """Test punctuation, case and whitespace are ignored."""
+        assert normalize_session_title("Hansard Report -  Tuesday, 5th") == "hansard report tuesday 5th"
+
+    def test_same_sitting_different_url(self, mirrored_sessions):
+        """Test mirrors of one sitting share a fingerprint."""
+        first, second, _ = mirrored_sessions
+        assert session_fingerprint(first) == session_fingerprint(second)
+
+    def test_different_dates_differ(self, mirrored_sessions):
+        """Test different sittings get different fingerprints."""
+        first, _, third = mirrored_sessions
+        assert session_fingerprint(first) != session_fingerprint(third)
+
+    def test_house_distinguishes(self):
+        """Test the same date and title in different houses differ."""
+        assembly = {'date': '2024-03-05', 'title': 'Hansard', 'house': 'National Assembly'}
+        senate = {'date': '2024-03-05', 'title': 'Hansard', 'house': 'Senate'}
+        assert session_fingerprint(assembly) != session_fingerprint(senate)
+
+    def test_missing_fields(self):
+        """Test records with missing fields still fingerprint."""
+        assert len(session_fingerprint({})) == 64
+
+
+class TestDedupeSessions:
+    """Test suite for session deduplication."""
+
+    def test_duplicates_collapsed(self, mirrored_sessions):
+        """Test one record is kept per sitting."""
+        result = dedupe_sessions(mirrored_sessions)
+        assert [s['date'] for s in result] == ['2024-03-05', '2024-03-06']
+
+    def test_most_populated_record_kept(self, mirrored_sessions):
+        """Test the record with more fields wins."""
+        result = dedupe_sessions(mirrored_sessions)
+        assert result[0]['url'] == 'https://mirror.example.org/b.pdf'
+
+    def test_tie_keeps_first(self):
+        """Test equally populated duplicates keep the first seen."""
+        sessions = [
+            {'date': '2024-03-05', 'title': 'Hansard', 'url': 'first'},
+            {'date': '2024-03-05', 'title': 'hansard', 'url': 'second'},
+        ]
+        assert dedupe_sessions(sessions) == [sessions[0]]
+
+    def test_empty_values_not_counted(self):
+        """Test empty strings and None do not count as populated."""
+        sessions = [
+            {'date': '2024-03-05', 'title': 'Hansard', 'url': 'first', 'pdf_path': 'a.pdf'},
+            {'date': '2024-03-05', 'title': 'Hansard', 'url': 'second', 'pdf_path': '', 'house': None},
+        ]
+        assert dedupe_sessions(sessions)[0]['url'] == 'first'
+
+    def test_empty_input(self):
+        """Test an empty list stays empty."""
+        assert dedupe_sessions([]) == []
+
+
+class TestSittingDay:
+    """Test suite for sitting day bucketing."""
+
+    def test_plain_date(self):
+        """Test YYYY-MM-DD dates are returned unchanged."""
+        assert sitting_day({'date': '2024-03-05'}) == '2024-03-05'
+
+    def test_late_evening_utc_timestamp(self):
+        """Test an evening sitting stored in UTC keeps its Kenyan day."""
+        # 20:30 UTC is 23:30 EAT
+        assert sitting_day({'date': '2024-03-05T20:30:00Z'}) == '2024-03-05'
+
+    def test_late_sitting_past_midnight(self):
+        """Test a late sitting past midnight in Nairobi is not put on the UTC day."""
+        # 00:15 EAT on 6 March is still 5 March in UTC
+        assert sitting_day({'date': '2024-03-06T00:15:00+03:00'}) == '2024-03-06'
+
+    def test_utc_timestamp_after_local_midnight(self):
+        """Test a UTC timestamp after midnight in Nairobi moves to the next day."""
+        session = {'date': datetime(2024, 3, 5, 21, 30, tzinfo=timezone.utc)}
+        assert sitting_day(session) == '2024-03-06'
+
+    def test_early_morning_local_timestamp(self):
+        """Test a timestamp that UTC would place on the previous day."""
+        # 01:00 EAT on 6 March is 22:00 UTC on 5 March
+        assert sitting_day({'date': '2024-03-06T01:00:00+03:00'}) == '2024-03-06'
+
+    def test_naive_values_taken_as_local(self):
+        """Test naive timestamps and date objects are not shifted."""
+        assert sitting_day({'date': datetime(2024, 3, 5, 23, 30)}) == '2024-03-05'
+        assert sitting_day({'date': date(2024, 3, 5)}) == '2024-03-05'
+
+    def test_missing_date(self):
+        """Test a session without a date is rejected."""
+        with pytest.raises(ValueError):
+            sitting_day({'title': 'No date'})
+
+
+class TestGroupSessions:
+    """Test suite for grouping sessions by day and month."""
+
+    @pytest.fixture
+    def sessions(self):
+        """Create morning, afternoon and evening sessions."""
+        return [
+            {'date': '2024-03-05T06:30:00Z', 'title': 'Morning'},
+            {'date': '2024-03-05T11:30:00Z', 'title': 'Afternoon'},
+            {'date': '2024-03-31T20:00:00Z', 'title': 'Evening'},
+            {'date': '2024-04-02', 'title': 'April'},
+        ]
+
+    def test_group_by_day(self, sessions):
+        """Test sittings on the same Kenyan day are grouped together."""
+        groups = group_sessions_by_day(sessions)
+
+        assert [s['title'] for s in groups['2024-03-05']] == ['Morning', 'Afternoon']
+        assert list(groups) == ['2024-03-05', '2024-03-31', '2024-04-02']
+
+    def test_group_by_month(self, sessions):
+        """Test a late-evening sitting at month end stays in its month."""
+        groups = group_sessions_by_month(sessions)
+
+        assert [s['title'] for s in groups['2024-03']] == ['Morning', 'Afternoon', 'Evening']
+        assert [s['title'] for s in groups['2024-04']] == ['April']
+
+    def test_undated_sessions_skipped(self, sessions):
+        """Test scraped sessions without a date are left out of both groupings."""
+        sessions.append({'date': None, 'title': 'Undated'})
+
+        assert list(group_sessions_by_day(sessions)) == ['2024-03-05', '2024-03-31', '2024-04-02']
+        assert list(group_sessions_by_month(sessions)) == ['2024-03', '2024-04']
+
+
+class TestTranscriptCompression:
+    """Test suite for transcript storage compression."""
+
+    def test_round_trip(self):
+        """Test a transcript survives compression unchanged."""
+        text = "Hon. Ng'ang'a: Asante sana, Mhe. Spika.\n" * 200
+
+        data = compress_transcript(text)
+
+        assert len(data) < len(text)
+        assert decompress_transcript(data) == text
+
+    def test_empty_transcript(self):
+        """Test an empty transcript round-trips."""
+        assert decompress_transcript(compress_transcript("")) == ""
+
+    def test_rejects_uncompressed_data(self):
+        """Test data without the gzip magic bytes is rejected."""
+        with pytest.raises(ValueError, match="not gzip"):
+            decompress_transcript(b"Hon. John Doe: plain text")
+
+    def test_rejects_corrupt_data(self):
+        """Test a damaged gzip stream is reported as corrupt."""
+        data = compress_transcript("Hon. John Doe: Statement." * 50)
+
+        with pytest.raises(ValueError, match="Corrupt"):
+            decompress_transcript(data[:len(data) // 2])
+
+
+class TestSummarizeSession:
+    """Test suite for one-call sitting summaries."""
+
+    def test_summary_counts(self):
+        """Test each count is taken from the matching parser."""
+        text = """NATIONAL ASSEMBLY
+OFFICIAL REPORT
+Tuesday, 5th March 2024
+QUESTIONS AND STATEMENTS
+Question No.12
+Hon. John Mbadi asked the Cabinet Secretary for Roads when the road will be built.
+The Speaker: Order! Cabinet Secretary.
+Hon. Aden Duale: The Finance Bill, 2024 provides funds for the road.
+Hon. John Mbadi: Thank you. (Applause) I also welcome the Finance Bill, 2024 and Bill No. 12.
+"""
+        summary = summarize_session(text)
+
+        assert summary == SessionSummary(
+            date="2024-03-05",
+            speaker_count=2,
+            statement_count=2,
+            word_count=9 + 13,
+            bills_mentioned=2,
+            question_count=1,
+            order_calls=1
+        )
+
+    def test_date_from_header(self):
+        """Test a date quoted in debate does not replace the header date."""
+        text = """Tuesday, 5th March 2024
+Hon. John Mbadi: The report tabled on 12/02/2024 is incomplete.
+"""
+        assert summarize_session(text).date == "2024-03-05"
+        assert summarize_session("Hon. John Mbadi: The report tabled on 12/02/2024.").date == "2024-02-12"
+
+    def test_empty_transcript(self):
+        """Test an empty transcript has an empty summary."""
+        assert summarize_session("") == SessionSummary()
+
+
+class TestSplitCompiledHansard:
+    """Test suite for splitting multi-sitting volumes."""
+
+    def test_split_on_sitting_headers(self):
+        """Test each sitting starts at its header block."""
+        text = """WEEKLY COMPILATION
+NATIONAL ASSEMBLY
+OFFICIAL REPORT
+Tuesday, 5th March, 2024
+The House met at 2.30 p.m.
+Hon. John Mbadi: We met on Tuesday, 5th March, 2024 as planned.
+NATIONAL ASSEMBLY
+OFFICIAL REPORT
+
+Wednesday, 6th March, 2024
+The House met at 9.30 a.m.
+Thursday 7th March 2024
+The House met at 2.30 p.m.
+"""
+        sittings = split_compiled_hansard(text)
+
+        assert len(sittings) == 3
+        assert sittings[0].startswith("WEEKLY COMPILATION")
+        assert sittings[0].endswith("as planned.")
+        assert sittings[1].startswith("NATIONAL ASSEMBLY\nOFFICIAL REPORT\n\nWednesday, 6th March, 2024")
+        assert sittings[2] == "Thursday 7th March 2024\nThe House met at 2.30 p.m."
+
+    def test_repeated_header_for_same_day(self):
+        """Test a running header with the same date does not split a sitting."""
+        text = "Tuesday, 5th March, 2024\nPage one.\nTuesday, 5th March, 2024\nPage two."
+
+        assert split_compiled_hansard(text) == [text]
+
+    def test_no_header(self):
+        """Test text without a sitting header is rejected."""
+        with pytest.raises(ValueError, match="No sitting header"):
+            split_compiled_hansard("Hon. John Mbadi: On Tuesday we met.")
+
+
+class TestRollingSittingCount:
+    """Test suite for rolling sitting counts."""
+
+    @pytest.fixture
+    def sessions(self):
+        """Create sittings spread over two months, including bad dates."""
+        return [
+            {'date': '2024-02-04'},
+            {'date': '2024-02-05'},
+            {'date': '2024-02-20'},
+            {'date': '2024-03-05'},
+            {'date': '2024-03-05T09:30:00+03:00'},
+            {'date': '2024-03-06'},
+            {'date': None},
+            {'date': 'not a date'},
+            {},
+        ]
+
+    def test_window_is_inclusive(self, sessions):
+        """Test sittings on both the first and last day of the window count."""
+        assert rolling_sitting_count(sessions, timedelta(days=30), at=date(2024, 3, 6)) == 5
+
+    def test_morning_and_afternoon_counted_separately(self, sessions):
+        """Test two sittings on one day count twice."""
+        assert rolling_sitting_count(sessions, timedelta(days=0), at=date(2024, 3, 5)) == 2
+
+    def test_datetime_reference(self, sessions):
+        """Test a datetime reference uses its date."""
+        assert rolling_sitting_count(sessions, timedelta(days=15), at=datetime(2024, 2, 20, 23, 0)) == 2
+
+    def test_aware_reference_uses_sitting_timezone(self, sessions):
+        """Test 23:00 UTC on 5 March ends the window on 6 March in Nairobi."""
+        at = datetime(2024, 3, 5, 23, 0, tzinfo=timezone.utc)
+
+        assert rolling_sitting_count(sessions, timedelta(days=0), at=at) == 1
+
+    def test_default_window(self, sessions):
+        """Test the default window is 30 days."""
+        assert rolling_sitting_count(sessions, at=date(2024, 3, 6)) == 5
+        assert rolling_sitting_count([], at=date(2024, 3, 6)) == 0
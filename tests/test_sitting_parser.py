@@ -0,0 +1,330 @@
+"""
+Tests for sitting-level metadata extraction.
+"""
+
+import pytest
+
+from hansard_tales.processors.sitting_parser import (
+    ADJOURNMENT_ORDINARY,
+    ADJOURNMENT_SINE_DIE,
+    PRAYER_CHRISTIAN,
+    PRAYER_MULTI_FAITH,
+    PRAYER_UNKNOWN,
+    VOTE_AGREED,
+    VOTE_NEGATIVED,
+    ChairEvent,
+    ClosureEvent,
+    SittingParser,
+    VoteOutcome,
+)
+
+
+@pytest.fixture
+def parser():
+    """Create a sitting parser instance for testing."""
+    return SittingParser()
+
+
+@pytest.fixture
+def sample_header():
+    """Create a sample Official Report header."""
+    return """
+    NATIONAL ASSEMBLY
+    OFFICIAL REPORT
+    Vol. III No. 42
+    Tuesday, 14th February, 2023
+    The House met at 2.30 p.m.
+    """
+
+
+class TestVolumeInfo:
+    """Test suite for Official Report volume extraction."""
+
+    def test_extract_volume_and_number(self, parser, sample_header):
+        """Test extracting a roman-numeral volume and number."""
+        assert parser.extract_volume_info(sample_header) == ("III", "42")
+
+    def test_extract_arabic_volume(self, parser):
+        """Test volumes printed with arabic numerals."""
+        text = "NATIONAL ASSEMBLY OFFICIAL REPORT Vol. 2, No. 014"
+        assert parser.extract_volume_info(text) == ("2", "14")
+
+    def test_extract_volume_word(self, parser):
+        """Test the spelled-out 'Volume' label."""
+        text = "NATIONAL ASSEMBLY\nOFFICIAL REPORT\nVolume iv No 7"
+        assert parser.extract_volume_info(text) == ("IV", "7")
+
+    def test_volume_outside_header_ignored(self, parser):
+        """Test volume-like text without the Official Report header."""
+        text = "Hon. John Doe: See Vol. 3 No. 9 of the report."
+        assert parser.extract_volume_info(text) is None
+
+    def test_no_volume(self, parser):
+        """Test headers without a volume line."""
+        assert parser.extract_volume_info("NATIONAL ASSEMBLY OFFICIAL REPORT") is None
+        assert parser.extract_volume_info("") is None
+
+
+class TestSittingDate:
+    """Test suite for the sitting date in the header."""
+
+    def test_header_date(self, parser):
+        """Test the weekday-and-date header line gives the sitting date."""
+        text = "NATIONAL ASSEMBLY\nOFFICIAL REPORT\nTuesday, 13th February, 2024\nThe House met at 2.30 p.m.\n"
+
+        assert parser.extract_sitting_date(text) == "2024-02-13"
+
+    def test_dates_in_debate_ignored(self, parser):
+        """Test dates within a line of debate are not a header."""
+        assert parser.extract_sitting_date("Hon. John Mbadi: On Tuesday, 13th February, 2024 we met.") is None
+        assert parser.extract_sitting_date("") is None
+
+
+class TestAdjournment:
+    """Test suite for adjournment detection."""
+
+    def test_ordinary_adjournment(self, parser):
+        """Test an adjournment to the next sitting."""
+        text = "ADJOURNMENT\nThe House rose at 6.30 p.m."
+        assert parser.detect_adjournment(text) == ADJOURNMENT_ORDINARY
+        assert not parser.is_adjournment_sine_die(text)
+
+    def test_adjourned_until(self, parser):
+        """Test the 'adjourned until' phrasing."""
+        text = "The House is adjourned until Tuesday, 7th March 2023 at 2.30 p.m."
+        assert parser.detect_adjournment(text) == ADJOURNMENT_ORDINARY
+        text = "Hon. Members, the House is, therefore, adjourned until tomorrow."
+        assert parser.detect_adjournment(text) == ADJOURNMENT_ORDINARY
+
+    def test_sine_die(self, parser):
+        """Test an adjournment sine die."""
+        text = "Hon. Members, the House is, therefore, adjourned sine die."
+        assert parser.is_adjournment_sine_die(text)
+        assert parser.detect_adjournment(text) == ADJOURNMENT_SINE_DIE
+
+    def test_sine_die_motion(self, parser):
+        """Test the motion to adjourn the House sine die."""
+        text = "THAT, this House adjourns the House sine die. The House rose at 7.00 p.m."
+        assert parser.detect_adjournment(text) == ADJOURNMENT_SINE_DIE
+
+    def test_no_adjournment(self, parser):
+        """Test text without an adjournment."""
+        assert parser.detect_adjournment("Hon. John Doe: I beg to move.") is None
+        assert parser.detect_adjournment("") is None
+        assert not parser.is_adjournment_sine_die("")
+
+
+class TestSpeakerInterventions:
+    """Test suite for counting the Chair's calls to order."""
+
+    def test_counts_each_call(self, parser):
+        """Test every "Order!" and "Hon. Members!" is counted."""
+        text = """The Speaker: Order! Order! Hon. Members! Let us have some order.
+Hon. John Mbadi: On a point of order! The Member is misleading the House.
+The Speaker: Order, Hon. Mbadi. hon members! Take your seats."""
+
+        assert parser.count_speaker_interventions(text) == 4
+
+    def test_member_calls_not_counted(self, parser):
+        """Test "Hon. Members!" from a Member is not a call to order."""
+        text = """Hon. John Mbadi: Hon. Members! We must pass this Bill.
+The Temporary Chairperson: Order! Proceed, Hon. Mbadi."""
+
+        assert parser.count_speaker_interventions(text) == 1
+        assert parser.count_speaker_interventions("Hon. Members! We must pass this Bill.") == 0
+
+    def test_no_interventions(self, parser):
+        """Test a calm sitting has no calls to order."""
+        assert parser.count_speaker_interventions("Hon. John Mbadi: I support the Order Paper.") == 0
+        assert parser.count_speaker_interventions("") == 0
+
+
+class TestVoteOutcome:
+    """Test suite for voice vote and division detection."""
+
+    @pytest.mark.parametrize("text, result", [
+        ("(Question put and agreed to)", VOTE_AGREED),
+        ("(Question put and negatived)", VOTE_NEGATIVED),
+        ("The Speaker: I think the Ayes have it.", VOTE_AGREED),
+        ("The Speaker: The Noes have it.", VOTE_NEGATIVED),
+    ])
+    def test_voice_votes(self, parser, text, result):
+        """Test voice votes give a result but no recorded division."""
+        assert parser.detect_vote_outcome(text) == VoteOutcome(result=result, recorded=False)
+
+    def test_recorded_division(self, parser):
+        """Test division totals are recorded and decide the result."""
+        text = """(The House divided)
+The results of the Division were as follows:
+AYES: 185
+NOES: 93
+ABSTENTIONS: 0
+(Question put and agreed to)"""
+        assert parser.detect_vote_outcome(text) == VoteOutcome(
+            result=VOTE_AGREED, recorded=True, ayes=185, noes=93
+        )
+
+    def test_division_lost(self, parser):
+        """Test a division with more Noes is negatived."""
+        outcome = parser.detect_vote_outcome("AYES - 40\nNOES - 120")
+        assert outcome.result == VOTE_NEGATIVED
+        assert outcome.recorded
+
+    def test_division_without_totals(self, parser):
+        """Test a division is recorded even before its totals are printed."""
+        text = "The Temporary Speaker: Ring the Division Bell.\n(The House divided)"
+        assert parser.detect_vote_outcome(text) == VoteOutcome(result=None, recorded=True)
+
+    def test_division_of_revenue_is_not_a_vote(self, parser):
+        """Test the Division of Revenue Bill is not mistaken for a division."""
+        assert parser.detect_vote_outcome("The Division of Revenue Bill, 2024 was read.") is None
+        assert parser.detect_vote_outcome("") is None
+
+
+class TestClosureMotions:
+    """Test suite for closure motion detection."""
+
+    def test_closures_with_decisions(self, parser):
+        """Test each closure motion is paired with its decision."""
+        text = """Hon. Aden Duale: Hon. Speaker, I beg to move that the Question be now put.
+(Question, that the Question be now put, put and agreed to)
+(Question put and agreed to)
+Hon. Kimani Ichung'wah: I beg to move that the Mover be now called upon to reply.
+(Question, that the Mover be now called upon to reply, put and negatived)"""
+        first = text.index("that the Question be now put")
+        second = text.index("that the Mover")
+
+        assert parser.detect_closure_motions(text) == [
+            ClosureEvent(position=first, carried=True),
+            ClosureEvent(position=second, carried=False),
+        ]
+
+    def test_undecided_motion(self, parser):
+        """Test a closure motion without a recorded decision."""
+        text = "Hon. Aden Duale: I beg to move that the question be now put."
+
+        assert parser.detect_closure_motions(text) == [
+            ClosureEvent(position=text.index("that"), carried=None)
+        ]
+
+    def test_decision_without_motion(self, parser):
+        """Test a decision recorded without the motion is reported at the decision."""
+        text = "Hon. Members.\n(Question, that the Question be now put, put and agreed to)"
+
+        assert parser.detect_closure_motions(text) == [
+            ClosureEvent(position=text.index("("), carried=True)
+        ]
+
+    def test_no_closure(self, parser):
+        """Test ordinary decisions are not closures."""
+        assert parser.detect_closure_motions("(Question put and agreed to)") == []
+        assert parser.detect_closure_motions("") == []
+
+
+class TestPrayerType:
+    """Test suite for opening prayer detection."""
+
+    def test_christian_prayers(self, parser, sample_header):
+        """Test a plain PRAYERS heading means Christian prayers."""
+        assert parser.detect_prayer_type(sample_header + "\nPRAYERS\n") == PRAYER_CHRISTIAN
+
+    @pytest.mark.parametrize("marker", [
+        "MULTI-FAITH PRAYERS",
+        "PRAYERS\n(Multi-faith prayers were said)",
+        "INTERFAITH PRAYERS",
+    ])
+    def test_multi_faith_prayers(self, parser, sample_header, marker):
+        """Test prayers marked as multi-faith."""
+        assert parser.detect_prayer_type(sample_header + "\n" + marker + "\n") == PRAYER_MULTI_FAITH
+
+    def test_later_mentions_ignored(self, parser, sample_header):
+        """Test mentions outside the opening do not count."""
+        text = sample_header + "PRAYERS\n" + "Hon. John Doe: Debate. " * 200 + "\nmulti-faith dialogue"
+        assert parser.detect_prayer_type(text) == PRAYER_CHRISTIAN
+
+    def test_no_prayers(self, parser, sample_header):
+        """Test text without prayers is unknown."""
+        assert parser.detect_prayer_type(sample_header) == PRAYER_UNKNOWN
+        assert parser.detect_prayer_type("") == PRAYER_UNKNOWN
+
+
+class TestChairChanges:
+    """Test suite for presiding officer changes."""
+
+    def test_parse_chair_changes(self, parser):
+        """Test each chair change is returned with role, name and position."""
+        text = (
+            "[The Speaker (Hon. Moses Wetang'ula) in the Chair]\n"
+            "Hon. John Doe: I rise to support.\n"
+            "[The Temporary Deputy Speaker (Hon. (Ms.) Martha Wangari) took the Chair]\n"
+            "Hon. Jane Smith: Thank you.\n"
+        )
+        events = parser.parse_chair_changes(text)
+
+        assert events == [
+            ChairEvent(role="Speaker", name="Moses Wetang'ula", position=0),
+            ChairEvent(
+                role="Temporary Deputy Speaker",
+                name="Martha Wangari",
+                position=text.index("[The Temporary")
+            ),
+        ]
+
+    def test_temporary_chairperson(self, parser):
+        """Test the Committee of the Whole House chair variant."""
+        events = parser.parse_chair_changes("[THE TEMPORARY CHAIRPERSON (Hon. Jackson Kosgei) in the Chair]")
+
+        assert events[0].role == "Temporary Chairperson"
+        assert events[0].name == "Jackson Kosgei"
+
+    def test_role_without_name(self, parser):
+        """Test annotations naming only the role."""
+        text = "[Mr. Speaker left the Chair] [The Deputy Speaker in the Chair]"
+        events = parser.parse_chair_changes(text)
+
+        assert events == [ChairEvent(role="Deputy Speaker", name="", position=text.index("[The"))]
+
+    def test_no_chair_changes(self, parser):
+        """Test text without chair annotations."""
+        assert parser.parse_chair_changes("Hon. John Doe: Thank you.") == []
+        assert parser.parse_chair_changes("") == []
+
+
+class TestOfficers:
+    """Test suite for officers of the House."""
+
+    def test_extract_officers(self, parser):
+        """Test officers near the header are mapped to canonical offices."""
+        text = """
+        NATIONAL ASSEMBLY
+        OFFICIAL REPORT
+        Clerk at the Table: Mr. Samuel Njoroge
+        Serjeant-at-Arms - Ms. Jane Wambui.
+        The House met at 2.30 p.m.
+        """
+
+        assert parser.extract_officers(text) == {
+            "Clerk at the Table": "Mr. Samuel Njoroge",
+            "Serjeant-at-Arms": "Ms. Jane Wambui",
+        }
+
+    def test_office_variants(self, parser):
+        """Test spelling variants and first-name-wins for repeated offices."""
+        text = (
+            "CLERK OF THE NATIONAL ASSEMBLY: Mr. Samuel Njoroge\n"
+            "Sergeant at Arms: Mr. John Doe\n"
+            "Deputy Clerk: Ms. Serah Kioko\n"
+            "Clerk: Someone Else\n"
+        )
+
+        assert parser.extract_officers(text) == {
+            "Clerk": "Mr. Samuel Njoroge",
+            "Serjeant-at-Arms": "Mr. John Doe",
+            "Deputy Clerk": "Ms. Serah Kioko",
+        }
+
+    def test_no_officers(self, parser, sample_header):
+        """Test headers without officers."""
+        assert parser.extract_officers(sample_header) == {}
+        assert parser.extract_officers("Hon. John Doe: The Clerk will read the Order.") == {}
+        assert parser.extract_officers("") == {}
@@ -0,0 +1,271 @@
+"""
+Tests for speech length and participation metrics.
+"""
+
+from datetime import timedelta
+
+import pytest
+
+from hansard_tales.analysis.speech_metrics import (
+    ParticipationMetrics,
+    SpeechStats,
+    accumulate_speech_stats,
+    count_syllables,
+    count_words,
+    estimate_reading_time,
+    extract_editorial_notes,
+    participation_metrics,
+    readability_score,
+    speaking_share_over_term,
+    speaking_time_gini,
+    split_sentences,
+    strip_annotations,
+)
+from hansard_tales.processors.mp_identifier import Statement
+
+
+class TestStripAnnotations:
+    """Test suite for annotation removal."""
+
+    def test_removes_parenthesised_annotations(self):
+        """Test "(Applause)" style annotations are removed."""
+        assert strip_annotations("We must act now. (Applause) Thank you.") == \
+            "We must act now. Thank you."
+
+    def test_removes_square_bracket_annotations(self):
+        """Test chair-change annotations in square brackets are removed."""
+        text = "I support. [The Temporary Speaker (Hon. Omar) took the Chair] Proceed."
+        assert strip_annotations(text) == "I support. Proceed."
+
+    def test_empty_text(self):
+        """Test empty text strips to an empty string."""
+        assert strip_annotations("") == ""
+
+
+class TestExtractEditorialNotes:
+    """Test suite for separating editorial notes from speech."""
+
+    def test_notes_separated(self):
+        """Test notes are removed from the text and returned in order."""
+        text = """Hon. John Mbadi: Hon. Speaker, the road (Applause) is done.
+[Technical   hitch]
+Hon. Aden Duale: [The Temporary Speaker (Hon. Omar) took the Chair] I agree."""
+
+        cleaned, notes = extract_editorial_notes(text)
+
+        assert cleaned == (
+            "Hon. John Mbadi: Hon. Speaker, the road is done.\n"
+            "\n"
+            "Hon. Aden Duale: I agree."
+        )
+        assert notes == ["Applause", "Technical hitch", "The Temporary Speaker (Hon. Omar) took the Chair"]
+
+    def test_no_notes(self):
+        """Test text without notes is returned unchanged."""
+        assert extract_editorial_notes("Hon. John Mbadi: Thank you.") == ("Hon. John Mbadi: Thank you.", [])
+        assert extract_editorial_notes("") == ("", [])
+
+
+class TestCountWords:
+    """Test suite for word counting."""
+
+    def test_counts_words(self):
+        """Test plain words are counted."""
+        assert count_words("Healthcare funding is inadequate.") == 4
+
+    def test_ignores_annotations(self):
+        """Test annotation words are not counted."""
+        assert count_words("Healthcare funding (Loud consultations) is inadequate.") == 4
+
+
+class TestSplitSentences:
+    """Test suite for sentence splitting."""
+
+    def test_splits_on_terminal_punctuation(self):
+        """Test full stops, question marks and exclamations end sentences."""
+        assert split_sentences("We must act. Why wait? Now!") == ["We must act.", "Why wait?", "Now!"]
+
+    def test_titles_do_not_end_sentences(self):
+        """Test "Hon." and "No." abbreviations stay inside a sentence."""
+        text = "Hon. Speaker, I refer to Question No. 45. It is urgent."
+        assert split_sentences(text) == ["Hon. Speaker, I refer to Question No. 45.", "It is urgent."]
+
+    def test_annotations_removed(self):
+        """Test annotations are not counted as sentences."""
+        assert split_sentences("I support. (Applause) Thank you") == ["I support.", "Thank you"]
+        assert split_sentences("(Applause)") == []
+
+
+class TestReadability:
+    """Test suite for readability grading."""
+
+    @pytest.mark.parametrize("word,expected", [
+        ("cat", 1),
+        ("parliament", 3),
+        ("make", 1),
+        ("table", 2),
+        ("the", 1),
+        ("Kenya,", 2),
+        ("45", 0),
+    ])
+    def test_count_syllables(self, word, expected):
+        """Test the vowel-group syllable heuristic."""
+        assert count_syllables(word) == expected
+
+    def test_flesch_kincaid_grade(self):
+        """Test the grade follows the Flesch-Kincaid formula."""
+        # 6 words, 2 sentences, 6 syllables
+        assert readability_score("The cat sat. The dog ran.") == pytest.approx(0.39 * 3 + 11.8 - 15.59)
+
+    def test_complex_text_scores_higher(self):
+        """Test long sentences with long words give a higher grade."""
+        simple = "We need roads. We need schools."
+        complex_text = ("The constitutional implementation of devolved administrative "
+                        "responsibilities necessitates comprehensive intergovernmental coordination.")
+
+        assert readability_score(complex_text) > readability_score(simple)
+
+    def test_no_words(self):
+        """Test text without words scores zero."""
+        assert readability_score("") == 0.0
+        assert readability_score("(Applause)") == 0.0
+
+
+class TestEstimateReadingTime:
+    """Test suite for reading time estimates."""
+
+    def test_default_pace(self):
+        """Test 150 words take one minute at the default pace."""
+        statement = Statement("John Mbadi", " ".join(["word"] * 150), 0, 750)
+        assert estimate_reading_time(statement) == timedelta(minutes=1)
+
+    def test_custom_pace(self):
+        """Test a custom words-per-minute pace."""
+        statement = Statement("John Mbadi", " ".join(["word"] * 100), 0, 500)
+        assert estimate_reading_time(statement, words_per_minute=200) == timedelta(seconds=30)
+
+    def test_annotations_excluded(self):
+        """Test annotations do not add to the estimate."""
+        statement = Statement("John Mbadi", "One two three. (Applause)", 0, 25)
+        assert estimate_reading_time(statement, words_per_minute=3) == timedelta(minutes=1)
+
+    def test_empty_statement(self):
+        """Test an empty statement takes no time."""
+        statement = Statement("John Mbadi", "", 0, 0)
+        assert estimate_reading_time(statement) == timedelta(0)
+
+    def test_invalid_pace(self):
+        """Test a non-positive pace is rejected."""
+        statement = Statement("John Mbadi", "Words.", 0, 6)
+        with pytest.raises(ValueError):
+            estimate_reading_time(statement, words_per_minute=0)
+
+
+class TestAccumulateSpeechStats:
+    """Test suite for per-MP speech statistics."""
+
+    def test_totals_per_mp(self):
+        """Test statements and words are totalled per MP."""
+        statements = [
+            Statement("John Mbadi", "Healthcare funding is inadequate.", 0, 30),
+            Statement("Aden Duale", "I support. (Applause)", 30, 50),
+            Statement("John Mbadi", "Thank you.", 50, 60),
+        ]
+
+        assert accumulate_speech_stats(statements) == {
+            "John Mbadi": SpeechStats(statement_count=2, word_count=6),
+            "Aden Duale": SpeechStats(statement_count=1, word_count=2),
+        }
+
+    def test_no_statements(self):
+        """Test no statements give no stats."""
+        assert accumulate_speech_stats([]) == {}
+
+
+class TestSpeakingTimeGini:
+    """Test suite for the speaking-time Gini coefficient."""
+
+    def test_all_equal(self):
+        """Test equal participation gives zero."""
+        per_mp = {name: SpeechStats(1, 100) for name in ("A", "B", "C")}
+        assert speaking_time_gini(per_mp) == 0.0
+
+    def test_single_speaker(self):
+        """Test a single speaker gives zero."""
+        assert speaking_time_gini({"A": SpeechStats(3, 500)}) == 0.0
+
+    def test_no_speakers_or_words(self):
+        """Test empty input and silent MPs give zero."""
+        assert speaking_time_gini({}) == 0.0
+        assert speaking_time_gini({"A": SpeechStats(), "B": SpeechStats()}) == 0.0
+
+    def test_one_speaker_dominates(self):
+        """Test one MP speaking all the words."""
+        per_mp = {"A": SpeechStats(1, 100), "B": SpeechStats(), "C": SpeechStats(), "D": SpeechStats()}
+        assert speaking_time_gini(per_mp) == pytest.approx(0.75)
+
+    def test_known_value(self):
+        """Test a hand-computed coefficient."""
+        per_mp = {"A": SpeechStats(1, 10), "B": SpeechStats(1, 20), "C": SpeechStats(1, 30)}
+        assert speaking_time_gini(per_mp) == pytest.approx(2 / 9)
+
+
+class TestSpeakingShareOverTerm:
+    """Test suite for term-level speaking shares."""
+
+    def test_shares_across_sessions(self):
+        """Test words are totalled across sessions before taking shares."""
+        per_session = {
+            'S1': {'John Mbadi': SpeechStats(statement_count=2, word_count=300),
+                   'Aden Duale': SpeechStats(statement_count=1, word_count=100)},
+            'S2': {'Aden Duale': SpeechStats(statement_count=3, word_count=500),
+                   'Ruth Odinga': SpeechStats(statement_count=1, word_count=100)},
+        }
+
+        shares = speaking_share_over_term(per_session)
+
+        assert shares == {
+            'John Mbadi': pytest.approx(0.3),
+            'Aden Duale': pytest.approx(0.6),
+            'Ruth Odinga': pytest.approx(0.1),
+        }
+        assert sum(shares.values()) == pytest.approx(1.0)
+
+    def test_no_words(self):
+        """Test a term with no words spoken has no shares."""
+        assert speaking_share_over_term({}) == {}
+        assert speaking_share_over_term({'S1': {'John Mbadi': SpeechStats(statement_count=1)}}) == {}
+
+
+class TestParticipationMetrics:
+    """Test suite for sitting participation metrics."""
+
+    def test_few_speakers(self):
+        """Test a sitting with fewer speakers than the top-speaker cut-off."""
+        statements = [
+            Statement("John Mbadi", "One two three.", 0, 20),
+            Statement("Aden Duale", "Four.", 20, 30),
+            Statement("John Mbadi", "Five six.", 30, 40),
+        ]
+
+        assert participation_metrics(statements) == ParticipationMetrics(
+            distinct_speakers=2,
+            total_statements=3,
+            top_speaker_share=1.0
+        )
+
+    def test_top_speaker_concentration(self):
+        """Test the share of words spoken by the top five MPs."""
+        statements = [
+            Statement(f"MP {i}", " ".join(["word"] * words), 0, 10)
+            for i, words in enumerate([40, 30, 10, 10, 5, 3, 2])
+        ]
+
+        metrics = participation_metrics(statements)
+
+        assert metrics.distinct_speakers == 7
+        assert metrics.top_speaker_share == pytest.approx(0.95)
+
+    def test_no_statements(self):
+        """Test an empty sitting."""
+        assert participation_metrics([]) == ParticipationMetrics()
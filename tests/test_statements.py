@@ -0,0 +1,418 @@
+"""
+Tests for statement utilities.
+"""
+
+from datetime import timedelta
+
+import pytest
+
+from hansard_tales.processors.mp_identifier import (
+    STATEMENT_POINT_OF_ORDER,
+    STATEMENT_PROCEDURAL,
+    MPIdentifier,
+    Statement,
+)
+from hansard_tales.processors.section_parser import SectionParser
+from hansard_tales.statements import (
+    Chapter,
+    ParseComparison,
+    StatementRef,
+    TimedStatement,
+    align_statements_to_chapters,
+    compare_statement_parses,
+    find_duplicate_statements_across_sessions,
+    first_statement_per_mp,
+    group_into_debates,
+    sample_statements,
+    speaker_sequence,
+    split_short_statements,
+    statement_content_hash,
+    statement_paragraphs,
+    substantive_statements,
+)
+
+
+@pytest.fixture
+def statements():
+    """Create a numbered list of statements."""
+    return [Statement(f"MP {i}", f"Statement {i}.", i * 20, i * 20 + 15) for i in range(100)]
+
+
+class TestSampleStatements:
+    """Test suite for deterministic statement sampling."""
+
+    def test_sample_size(self, statements):
+        """Test the requested number of distinct statements is returned."""
+        sample = sample_statements(statements, 10, seed=1)
+
+        assert len(sample) == 10
+        assert len({s.mp_name for s in sample}) == 10
+
+    def test_same_seed_same_sample(self, statements):
+        """Test sampling is reproducible for a given seed."""
+        assert sample_statements(statements, 10, seed=42) == sample_statements(statements, 10, seed=42)
+
+    def test_different_seed_different_sample(self, statements):
+        """Test different seeds give different samples."""
+        assert sample_statements(statements, 10, seed=1) != sample_statements(statements, 10, seed=2)
+
+    def test_keeps_original_order(self, statements):
+        """Test sampled statements stay in document order."""
+        sample = sample_statements(statements, 20, seed=7)
+        positions = [s.start_position for s in sample]
+
+        assert positions == sorted(positions)
+
+    def test_n_larger_than_input(self, statements):
+        """Test asking for more statements than exist returns them all."""
+        sample = sample_statements(statements, 500, seed=1)
+
+        assert sample == statements
+        assert sample is not statements
+
+    def test_non_positive_n(self, statements):
+        """Test zero or negative sizes give an empty sample."""
+        assert sample_statements(statements, 0, seed=1) == []
+        assert sample_statements(statements, -3, seed=1) == []
+        assert sample_statements([], 5, seed=1) == []
+
+
+class TestSubstantiveStatements:
+    """Test suite for filtering out non-debate contributions."""
+
+    def test_procedural_and_points_of_order_dropped(self):
+        """Test only substantive statements are kept, in order."""
+        statements = [
+            Statement("A", "I rise to support this Bill.", 0, 10),
+            Statement("B", "On a point of order.", 10, 20, kind=STATEMENT_POINT_OF_ORDER),
+            Statement("C", "I beg to second.", 20, 30, kind=STATEMENT_PROCEDURAL),
+            Statement("D", "The roads in Kandara are impassable.", 30, 40),
+        ]
+
+        assert [s.mp_name for s in substantive_statements(statements)] == ["A", "D"]
+        assert substantive_statements([]) == []
+
+
+class TestSplitShortStatements:
+    """Test suite for separating statements below a word count."""
+
+    def test_short_statements_returned_separately(self):
+        """Test short statements are split off, both lists keeping input order."""
+        statements = [
+            Statement("A", "Thank you very much.", 0, 10),
+            Statement("B", "I rise to support this Bill fully.", 10, 20),
+            Statement("C", "Yes.", 20, 30),
+            Statement("D", "The roads in Kandara are impassable.", 30, 40),
+        ]
+
+        kept, short = split_short_statements(statements, min_words=5)
+
+        assert [s.mp_name for s in kept] == ["B", "D"]
+        assert [s.mp_name for s in short] == ["A", "C"]
+
+    def test_annotations_not_counted(self):
+        """Test editorial annotations do not count towards the minimum."""
+        statements = [Statement("A", "(Applause) Yes.", 0, 10)]
+
+        kept, short = split_short_statements(statements, min_words=2)
+
+        assert kept == []
+        assert [s.text for s in short] == ["(Applause) Yes."]
+
+    def test_zero_keeps_everything(self):
+        """Test a minimum of zero keeps every statement."""
+        statements = [Statement("A", "(Applause)", 0, 10), Statement("B", "Yes.", 10, 20)]
+
+        assert split_short_statements(statements, min_words=0) == (statements, [])
+        assert split_short_statements([], min_words=5) == ([], [])
+
+
+class TestSpeakerSequence:
+    """Test suite for speaker turn order."""
+
+    @pytest.fixture
+    def exchange(self):
+        """Create a short exchange with a repeated turn."""
+        return [
+            Statement("John Mbadi", "Question.", 0, 10),
+            Statement("John Mbadi", "Continued after page break.", 10, 40),
+            Statement("Aden Duale", "Answer.", 40, 50),
+            Statement("John Mbadi", "Follow-up.", 50, 60),
+        ]
+
+    def test_every_statement_is_a_turn(self, exchange):
+        """Test each statement is listed by default."""
+        assert speaker_sequence(exchange) == ["John Mbadi", "John Mbadi", "Aden Duale", "John Mbadi"]
+
+    def test_collapse_consecutive(self, exchange):
+        """Test consecutive statements by one speaker form a single turn."""
+        assert speaker_sequence(exchange, collapse_consecutive=True) == ["John Mbadi", "Aden Duale", "John Mbadi"]
+
+    def test_empty(self):
+        """Test no statements give no turns."""
+        assert speaker_sequence([]) == []
+
+
+class TestStatementParagraphs:
+    """Test suite for paragraph splitting."""
+
+    def test_split_on_blank_lines(self):
+        """Test paragraphs are split on blank lines with wrapped lines joined."""
+        text = "Thank you, Hon. Speaker.\n\nI rise to support\nthis Bill.\n \n\n  It is timely.  "
+
+        assert statement_paragraphs(Statement("John Mbadi", text, 0, len(text))) == [
+            "Thank you, Hon. Speaker.",
+            "I rise to support this Bill.",
+            "It is timely.",
+        ]
+
+    def test_editorial_notes_and_empty_paragraphs_dropped(self):
+        """Test paragraphs holding only editorial notes are dropped."""
+        text = "I support.\n\n(Applause)\n\nThank you. [Technical hitch]\n\n"
+
+        assert statement_paragraphs(Statement("John Mbadi", text, 0, len(text))) == [
+            "I support.",
+            "Thank you.",
+        ]
+        assert statement_paragraphs(Statement("John Mbadi", "", 0, 0)) == []
+
+
+class TestStatementContentHash:
+    """Test suite for statement content hashing."""
+
+    def test_layout_and_annotations_ignored(self):
+        """Test whitespace and annotations do not change the hash."""
+        first = Statement("John Mbadi", "Hon. Speaker,\nI beg to move. (Applause)", 0, 40)
+        second = Statement("Aden Duale", "Hon. Speaker, I beg   to move.", 100, 140)
+
+        assert statement_content_hash(first) == statement_content_hash(second)
+
+    def test_different_text_different_hash(self):
+        """Test different words give different hashes."""
+        first = Statement("John Mbadi", "I beg to move.", 0, 20)
+        second = Statement("John Mbadi", "I beg to second.", 0, 20)
+
+        assert statement_content_hash(first) != statement_content_hash(second)
+
+
+class TestFindDuplicateStatementsAcrossSessions:
+    """Test suite for cross-sitting duplicate detection."""
+
+    def test_shared_content_grouped(self):
+        """Test content repeated across sittings lists each sitting once."""
+        prayer = "Let us pray."
+        per_session = {
+            '2024-03-05': [Statement("The Speaker", prayer, 0, 10), Statement("The Speaker", prayer, 50, 60)],
+            '2024-03-06': [Statement("John Mbadi", "Unique remarks.", 0, 20)],
+            '2024-03-07': [Statement("The Speaker", " Let us  pray. ", 0, 10)],
+        }
+
+        duplicates = find_duplicate_statements_across_sessions(per_session)
+
+        key = statement_content_hash(Statement("The Speaker", prayer, 0, 10))
+        assert duplicates == {key: ['2024-03-05', '2024-03-07']}
+
+    def test_repeats_within_one_session_ignored(self):
+        """Test content repeated only inside one sitting is not reported."""
+        per_session = {'a': [Statement("X", "Order!", 0, 6), Statement("Y", "Order!", 10, 16)]}
+
+        assert find_duplicate_statements_across_sessions(per_session) == {}
+
+    def test_empty_content_ignored(self):
+        """Test statements that are only annotations are not grouped."""
+        per_session = {
+            'a': [Statement("X", "(Applause)", 0, 10)],
+            'b': [Statement("Y", "", 0, 0)],
+        }
+
+        assert find_duplicate_statements_across_sessions(per_session) == {}
+
+
+class TestGroupIntoDebates:
+    """Test suite for grouping statements into debates."""
+
+    @pytest.fixture
+    def sitting(self):
+        """Create a sitting with two items of business."""
+        return """PRAYERS
+Hon. John Mbadi: Hon. Speaker, I rise on a point of order about the lights.
+BILLS
+THE FINANCE BILL, 2024
+Hon. Aden Duale: I beg to move that the Finance Bill, 2024 be now read a Second Time.
+Hon. Alice Wahome: I second the Finance Bill and urge Members to support it.
+MOTIONS
+ESTABLISHMENT OF A DISASTER FUND
+Hon. Opiyo Wandayi: I beg to move the following Motion:
+THAT, this House urges the Hon. Cabinet Secretary to establish a
+national disaster fund. I ask Hon. Mbadi to second.
+"""
+
+    def test_debates_from_headings(self, sitting):
+        """Test statements are grouped by the heading they fall under."""
+        statements = MPIdentifier().extract_statements(sitting)
+        debates = group_into_debates(statements, SectionParser().heading_positions(sitting))
+
+        assert [[s.mp_name for s in debate.statements] for debate in debates] == [
+            ["John Mbadi"],
+            ["Aden Duale", "Alice Wahome"],
+            ["Opiyo Wandayi"],
+        ]
+        assert debates[1].start == sitting.index("THE FINANCE BILL")
+        assert debates[1].bill_reference == "Finance Bill 2024"
+        assert debates[1].motion == ""
+        assert debates[2].bill_reference == ""
+        assert debates[2].motion == (
+            "THAT, this House urges the Hon. Cabinet Secretary to establish a national disaster fund."
+        )
+
+    def test_statements_before_first_boundary(self):
+        """Test statements before any boundary form a debate starting at 0."""
+        statements = [Statement("A", "One.", 5, 10), Statement("B", "Two.", 50, 60)]
+        debates = group_into_debates(statements, [40])
+
+        assert [(d.start, [s.mp_name for s in d.statements]) for d in debates] == [(0, ["A"]), (40, ["B"])]
+
+    def test_no_boundaries(self):
+        """Test without boundaries all statements form one debate."""
+        statements = [Statement("A", "One.", 0, 10), Statement("B", "Two.", 10, 20)]
+
+        debates = group_into_debates(statements, [])
+
+        assert len(debates) == 1
+        assert debates[0].statements == statements
+        assert group_into_debates([], [10, 20]) == []
+
+
+class TestFirstStatementPerMP:
+    """Test suite for locating maiden speeches."""
+
+    def test_earliest_statement(self):
+        """Test each MP's first statement across ordered sessions is found."""
+        sessions = [{'id': 10, 'date': '2022-09-29'}, {'id': 11, 'date': '2022-10-04'}]
+        statements_by_session = {
+            10: [Statement("John Mbadi", "First.", 0, 10), Statement("John Mbadi", "Again.", 10, 20)],
+            11: [Statement("Alice Wahome", "Maiden speech.", 0, 20), Statement("John Mbadi", "Later.", 20, 30)],
+        }
+
+        assert first_statement_per_mp(sessions, statements_by_session) == {
+            "John Mbadi": StatementRef(session_id=10, index=0),
+            "Alice Wahome": StatementRef(session_id=11, index=0),
+        }
+
+    def test_session_order_decides(self):
+        """Test the given session order is trusted."""
+        sessions = [{'id': 'b'}, {'id': 'a'}]
+        statements_by_session = {'a': [Statement("X", "One.", 0, 5)], 'b': [Statement("X", "Two.", 0, 5)]}
+
+        assert first_statement_per_mp(sessions, statements_by_session)["X"] == StatementRef('b', 0)
+
+    def test_sessions_without_statements(self):
+        """Test sessions with no statements are skipped."""
+        assert first_statement_per_mp([{'id': 1}], {}) == {}
+
+
+class TestCompareStatementParses:
+    """Test suite for comparing two parses of a document."""
+
+    def test_matches_and_unique_statements(self):
+        """Test overlapping same-speaker statements match and the rest are unique."""
+        a = [
+            Statement("John Mbadi", "x", 0, 100),
+            Statement("Aden Duale", "y", 100, 200),
+            Statement("Mary Emaase", "z", 200, 300),
+        ]
+        b = [
+            Statement("John Mbadi", "x", 5, 95),
+            Statement("Aden Duale", "y", 100, 150),
+            Statement("Aden Duale", "y", 150, 200),
+            Statement("Mary Emase", "z", 200, 300),
+        ]
+
+        comparison = compare_statement_parses(a, b)
+
+        assert comparison.matched == 2
+        assert comparison.only_in_a == [a[2]]
+        assert comparison.only_in_b == [b[2], b[3]]
+        assert comparison.agreement == pytest.approx(4 / 7)
+
+    def test_largest_overlap_preferred(self):
+        """Test a statement is matched to the candidate it overlaps most."""
+        a = [Statement("John Mbadi", "x", 0, 100)]
+        b = [Statement("John Mbadi", "x", 90, 200), Statement("John Mbadi", "x", 0, 90)]
+
+        assert compare_statement_parses(a, b).only_in_b == [b[0]]
+
+    def test_adjacent_spans_do_not_match(self):
+        """Test spans that only touch are not an overlap."""
+        a = [Statement("John Mbadi", "x", 0, 100)]
+        b = [Statement("John Mbadi", "x", 100, 200)]
+
+        assert compare_statement_parses(a, b).matched == 0
+
+    def test_same_offsets_on_different_pages_do_not_match(self):
+        """Test page-relative spans on different pages are not an overlap."""
+        a = [
+            Statement("John Mbadi", "x", 0, 100, page_number=1),
+            Statement("John Mbadi", "y", 0, 100, page_number=2),
+        ]
+        b = [Statement("John Mbadi", "y", 10, 90, page_number=2)]
+
+        comparison = compare_statement_parses(a, b)
+
+        assert comparison.matched == 1
+        assert comparison.only_in_a == [a[0]]
+        assert comparison.only_in_b == []
+
+    def test_identical_and_empty_parses(self):
+        """Test identical parses agree fully."""
+        statements = [Statement("John Mbadi", "x", 0, 100)]
+
+        assert compare_statement_parses(statements, list(statements)) == ParseComparison(matched=1)
+        assert compare_statement_parses([], []).agreement == 1.0
+
+
+class TestAlignStatementsToChapters:
+    """Test suite for estimating statement times from video chapters."""
+
+    def test_time_shared_by_word_count(self):
+        """Test each chapter's span is divided in proportion to words."""
+        statements = [
+            Statement("A", "one two three", 100, 150),
+            Statement("B", "one", 150, 200),
+            Statement("C", "one two", 500, 600),
+        ]
+        chapters = [
+            Chapter("Bills", timedelta(minutes=40), timedelta(minutes=60), 450),
+            Chapter("Motions", timedelta(minutes=0), timedelta(minutes=20), 90),
+        ]
+
+        timed = align_statements_to_chapters(statements, chapters)
+
+        assert timed == [
+            TimedStatement(statements[0], timedelta(minutes=0), timedelta(minutes=15)),
+            TimedStatement(statements[1], timedelta(minutes=15), timedelta(minutes=20)),
+            TimedStatement(statements[2], timedelta(minutes=40), timedelta(minutes=60)),
+        ]
+
+    def test_statements_before_first_chapter_omitted(self):
+        """Test statements preceding every chapter have no time."""
+        statements = [Statement("A", "early", 0, 10), Statement("B", "late", 20, 30)]
+        chapters = [Chapter("Motions", timedelta(0), timedelta(minutes=5), 15)]
+
+        assert [t.statement for t in align_statements_to_chapters(statements, chapters)] == [statements[1]]
+
+    def test_wordless_statements_share_equally(self):
+        """Test a chapter of statements without words is split evenly."""
+        statements = [Statement("A", "(Applause)", 0, 10), Statement("B", "", 10, 20)]
+        chapters = [Chapter("Prayers", timedelta(0), timedelta(minutes=2), 0)]
+
+        timed = align_statements_to_chapters(statements, chapters)
+
+        assert [(t.start, t.end) for t in timed] == [
+            (timedelta(0), timedelta(minutes=1)),
+            (timedelta(minutes=1), timedelta(minutes=2)),
+        ]
+
+    def test_no_chapters(self):
+        """Test nothing can be aligned without chapters."""
+        assert align_statements_to_chapters([Statement("A", "text", 0, 10)], []) == []
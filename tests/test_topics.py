@@ -0,0 +1,165 @@
+"""
+Tests for keyword and topic analysis.
+"""
+
+import pytest
+
+from hansard_tales.analysis.topics import (
+    CATEGORIES,
+    CATEGORY_AGRICULTURE,
+    CATEGORY_ECONOMY,
+    CATEGORY_HEALTH,
+    CATEGORY_SECURITY,
+    DEFAULT_CATEGORY_LEXICONS,
+    classify_statement,
+    extract_keywords,
+    set_category_lexicon,
+    tokenize,
+    top_topics_for_mp,
+    topic_share_by_party,
+)
+from hansard_tales.processors.mp_identifier import Statement
+
+
+@pytest.fixture
+def mbadi_statements():
+    """Create sample statements by a single MP."""
+    return [
+        Statement("John Mbadi", "Mr. Speaker, healthcare funding in our hospitals is inadequate.", 0, 70),
+        Statement("John Mbadi", "Hospitals in rural areas lack healthcare workers and funding.", 70, 140),
+        Statement("John Mbadi", "The budget must prioritise education and healthcare.", 140, 200),
+    ]
+
+
+class TestTokenize:
+    """Test suite for tokenization."""
+
+    def test_lowercases_and_splits(self):
+        """Test words are lowercased and punctuation removed."""
+        assert tokenize("Healthcare, Education!") == ["healthcare", "education"]
+
+    def test_keeps_internal_apostrophes(self):
+        """Test apostrophes inside words are kept."""
+        assert tokenize("Murang'a's roads") == ["murang'a's", "roads"]
+
+    def test_empty_text(self):
+        """Test empty text has no tokens."""
+        assert tokenize("") == []
+
+
+class TestExtractKeywords:
+    """Test suite for keyword extraction."""
+
+    def test_stopwords_removed(self):
+        """Test common and procedural words are not keywords."""
+        keywords = dict(extract_keywords("Thank you, Mr. Speaker. I rise to support the Motion on roads."))
+        assert keywords == {'roads': 1}
+
+    def test_ordering_and_limit(self):
+        """Test keywords are ordered by frequency and limited."""
+        text = "roads roads roads water water schools"
+        assert extract_keywords(text, top_n=2) == [('roads', 3), ('water', 2)]
+
+
+class TestTopTopicsForMP:
+    """Test suite for per-MP top topics."""
+
+    def test_most_frequent_first(self, mbadi_statements):
+        """Test topics are aggregated across statements."""
+        topics = top_topics_for_mp(mbadi_statements, top_n=3)
+        assert topics == ['healthcare', 'funding', 'hospitals']
+
+    def test_ties_broken_alphabetically(self):
+        """Test equal-frequency terms always come out in the same order."""
+        statements = [Statement("A", "water roads schools", 0, 10)]
+        assert top_topics_for_mp(statements, top_n=3) == ['roads', 'schools', 'water']
+
+    def test_stable_across_input_order(self, mbadi_statements):
+        """Test statement order does not change the result."""
+        forward = top_topics_for_mp(mbadi_statements, top_n=5)
+        backward = top_topics_for_mp(list(reversed(mbadi_statements)), top_n=5)
+        assert forward == backward
+
+    def test_no_statements(self):
+        """Test an MP with no statements has no topics."""
+        assert top_topics_for_mp([], top_n=5) == []
+
+    def test_non_positive_top_n(self, mbadi_statements):
+        """Test a non-positive limit returns nothing."""
+        assert top_topics_for_mp(mbadi_statements, top_n=0) == []
+
+
+@pytest.fixture
+def restore_lexicons():
+    """Restore the default category lexicons after a test."""
+    yield
+    for category, terms in DEFAULT_CATEGORY_LEXICONS.items():
+        set_category_lexicon(category, terms)
+
+
+class TestClassifyStatement:
+    """Test suite for policy category classification."""
+
+    def test_single_category(self):
+        """Test a statement about one policy area."""
+        text = "Our hospitals have no doctors and patients are suffering."
+        assert classify_statement(text) == [CATEGORY_HEALTH]
+
+    def test_multiple_categories(self):
+        """Test a statement can belong to several categories."""
+        text = "The budget cuts hospital funding while tax on medicine rises."
+        assert classify_statement(text) == [CATEGORY_HEALTH, CATEGORY_ECONOMY]
+
+    def test_below_threshold(self):
+        """Test a single passing mention does not classify a statement."""
+        assert classify_statement("I visited a school yesterday.") == []
+
+    def test_empty_text(self):
+        """Test empty text has no categories."""
+        assert classify_statement("") == []
+
+    def test_override_lexicon(self, restore_lexicons):
+        """Test a custom lexicon replaces the default one."""
+        set_category_lexicon(CATEGORY_SECURITY, ["Cattle", "rustling"])
+
+        assert classify_statement("Cattle rustling continues in the north.") == [CATEGORY_SECURITY]
+        assert classify_statement("Police officers were deployed.") == []
+
+    def test_unknown_category(self):
+        """Test overriding an unknown category is rejected."""
+        with pytest.raises(KeyError):
+            set_category_lexicon("Sport", ["football"])
+
+
+class TestTopicShareByParty:
+    """Test suite for topic share by party."""
+
+    def test_shares_normalized_within_party(self):
+        """Test each party's shares are fractions of its own statements."""
+        mps = [
+            {'id': 1, 'name': 'A', 'party': 'ODM'},
+            {'id': 2, 'name': 'B', 'party': 'ODM'},
+            {'id': 3, 'name': 'C', 'party': 'UDA'},
+            {'id': 4, 'name': 'D'},
+        ]
+        health = "Our hospitals have no doctors and patients are suffering."
+        farming = "Farmers need fertilizer for maize."
+        statements_by_mp = {
+            1: [Statement("A", health, 0, 10), Statement("A", "Thank you.", 10, 20)],
+            2: [Statement("B", farming, 0, 10), Statement("B", health, 10, 20)],
+            3: [Statement("C", health, 0, 10)],
+            4: [Statement("D", health, 0, 10)],
+        }
+
+        shares = topic_share_by_party(statements_by_mp, mps)
+
+        assert set(shares) == {'ODM', 'UDA'}
+        assert set(shares['ODM']) == set(CATEGORIES)
+        assert shares['ODM'][CATEGORY_HEALTH] == 0.5
+        assert shares['ODM'][CATEGORY_AGRICULTURE] == 0.25
+        assert shares['ODM'][CATEGORY_ECONOMY] == 0.0
+        assert shares['UDA'][CATEGORY_HEALTH] == 1.0
+
+    def test_parties_without_statements_omitted(self):
+        """Test silent parties have no shares."""
+        assert topic_share_by_party({}, [{'id': 1, 'party': 'ODM'}]) == {}
@@ -0,0 +1,341 @@
+"""
+Tests for record validation.
+"""
+from datetime import date, datetime
+
+import pytest
+
+from hansard_tales.analysis.performance import PerformanceBreakdown, performance_breakdown
+from hansard_tales.validation import (
+    ValidationError,
+    normalize_phone,
+    validate_breakdown,
+    validate_dataset,
+    validate_hansard_session,
+    validate_mp,
+    validate_performance_inputs,
+    validate_url,
+)
+
+
+@pytest.fixture
+def valid_session():
+    """Create a valid session record."""
+    return {
+        'date': '2024-03-05',
+        'title': 'Hansard Report - Tuesday, 5th March 2024',
+        'pdf_url': 'https://parliament.go.ke/hansard.pdf',
+    }
+
+
+class TestValidateHansardSession:
+    """Test suite for session validation."""
+
+    def test_valid_session(self, valid_session):
+        """Test a complete session passes both modes."""
+        validate_hansard_session(valid_session)
+        validate_hansard_session(valid_session, strict=True, now=date(2024, 6, 1))
+
+    def test_scraper_url_key_accepted(self, valid_session):
+        """Test scraper records using 'url' instead of 'pdf_url'."""
+        del valid_session['pdf_url']
+        valid_session['url'] = 'https://parliament.go.ke/hansard.pdf'
+        validate_hansard_session(valid_session)
+
+    def test_missing_date(self, valid_session):
+        """Test a missing date is rejected."""
+        del valid_session['date']
+        with pytest.raises(ValidationError, match="date is required"):
+            validate_hansard_session(valid_session)
+
+    def test_invalid_date(self, valid_session):
+        """Test an impossible date is rejected."""
+        valid_session['date'] = '2024-13-45'
+        with pytest.raises(ValidationError, match="not a valid"):
+            validate_hansard_session(valid_session)
+
+    def test_all_problems_reported(self):
+        """Test every problem is listed, not just the first."""
+        with pytest.raises(ValidationError) as exc_info:
+            validate_hansard_session({})
+        assert len(exc_info.value.problems) == 2
+
+
+class TestFutureDates:
+    """Test suite for the strict future-date rule."""
+
+    def test_future_date_rejected_in_strict_mode(self, valid_session):
+        """Test a sitting after now is rejected."""
+        valid_session['date'] = '2031-03-05'
+        with pytest.raises(ValidationError, match="in the future"):
+            validate_hansard_session(valid_session, strict=True, now=date(2024, 6, 1))
+
+    def test_future_date_allowed_in_lenient_mode(self, valid_session):
+        """Test lenient mode does not check the date against now."""
+        valid_session['date'] = '2031-03-05'
+        validate_hansard_session(valid_session, now=date(2024, 6, 1))
+
+    def test_same_day_allowed(self, valid_session):
+        """Test a sitting dated today is accepted."""
+        validate_hansard_session(valid_session, strict=True, now=datetime(2024, 3, 5, 9, 30))
+
+    def test_day_after_now_rejected(self, valid_session):
+        """Test the rule applies from the very next day."""
+        with pytest.raises(ValidationError):
+            validate_hansard_session(valid_session, strict=True, now=datetime(2024, 3, 4, 23, 59))
+
+    def test_strict_requires_title(self, valid_session):
+        """Test strict mode requires a title."""
+        valid_session['title'] = '  '
+        with pytest.raises(ValidationError, match="title is required"):
+            validate_hansard_session(valid_session, strict=True, now=date(2024, 6, 1))
+
+
+class TestValidateURL:
+    """Test suite for URL validation."""
+
+    @pytest.mark.parametrize("url", [
+        "https://parliament.go.ke/hansard.pdf",
+        "http://www.parliament.go.ke/sites/default/files/photo.jpg",
+    ])
+    def test_valid_urls(self, url):
+        """Test absolute http and https URLs pass."""
+        validate_url(url)
+
+    @pytest.mark.parametrize("url, message", [
+        ("ftp://parliament.go.ke/hansard.pdf", "must use http or https"),
+        ("parliament.go.ke/hansard.pdf", "must use http or https"),
+        ("https://", "has no host"),
+        ("", "url is required"),
+    ])
+    def test_invalid_urls(self, url, message):
+        """Test bad schemes, missing hosts and empty values are rejected."""
+        with pytest.raises(ValidationError, match=message):
+            validate_url(url)
+
+    def test_optional_empty_url(self):
+        """Test empty values pass when the URL is optional."""
+        validate_url("", required=False)
+        validate_url(None, required=False)
+
+    def test_field_name_in_message(self):
+        """Test the field name is used in the problem message."""
+        with pytest.raises(ValidationError, match="photo_url"):
+            validate_url("ftp://x", field="photo_url")
+
+    def test_session_uses_url_check(self, valid_session):
+        """Test session validation rejects non-http PDF URLs."""
+        valid_session['pdf_url'] = 'ftp://parliament.go.ke/hansard.pdf'
+        with pytest.raises(ValidationError, match="pdf_url"):
+            validate_hansard_session(valid_session)
+
+
+class TestValidateMP:
+    """Test suite for MP validation."""
+
+    def test_valid_mp(self):
+        """Test a complete MP record passes."""
+        validate_mp({'name': 'John Doe', 'constituency': 'Test', 'photo_url': 'https://parliament.go.ke/a.jpg'})
+        validate_mp({'name': 'John Doe', 'constituency': 'Test', 'photo_url': None})
+
+    def test_missing_fields(self):
+        """Test missing name and constituency are both reported."""
+        with pytest.raises(ValidationError) as exc_info:
+            validate_mp({'name': ' ', 'constituency': None})
+
+        assert exc_info.value.problems == ["name is required", "constituency is required"]
+
+    def test_invalid_photo_url(self):
+        """Test a photo URL without a scheme is rejected."""
+        with pytest.raises(ValidationError, match="photo_url"):
+            validate_mp({'name': 'John Doe', 'constituency': 'Test', 'photo_url': 'example.com/a.jpg'})
+
+    def test_strict_requires_known_status(self):
+        """Test strict mode requires a recognised membership status."""
+        mp = {'name': 'John Doe', 'constituency': 'Test'}
+        validate_mp(mp)
+        validate_mp({**mp, 'membership_status': 'Unseated'}, strict=True)
+
+        with pytest.raises(ValidationError, match="membership_status is required"):
+            validate_mp(mp, strict=True)
+
+        with pytest.raises(ValidationError, match="membership_status 'retired' is not one of"):
+            validate_mp({**mp, 'membership_status': 'retired'}, strict=True)
+
+    def test_strict_accepts_scraped_records(self):
+        """Test elected and nominated members from the scraper pass strict mode."""
+        for status in ('Elected', 'Nominated'):
+            validate_mp({'name': 'John Doe', 'constituency': 'Test', 'status': status}, strict=True)
+
+    def test_contact_fields(self):
+        """Test valid contact details pass and empty ones are not checked."""
+        validate_mp({'name': 'John Doe', 'constituency': 'Test', 'email': 'jdoe@parliament.go.ke', 'phone': '0712 345 678'})
+        validate_mp({'name': 'John Doe', 'constituency': 'Test', 'email': '', 'phone': None})
+
+    def test_phone_normalized(self):
+        """Test a valid phone is stored back in E.164 form."""
+        mp = {'name': 'John Doe', 'constituency': 'Test', 'phone': '0712 345 678'}
+
+        validate_mp(mp)
+
+        assert mp['phone'] == "+254712345678"
+
+    def test_invalid_record_unchanged(self):
+        """Test the phone is not rewritten when the record is invalid."""
+        mp = {'name': '', 'constituency': 'Test', 'phone': '0712 345 678'}
+
+        with pytest.raises(ValidationError):
+            validate_mp(mp)
+
+        assert mp['phone'] == "0712 345 678"
+
+    def test_invalid_contact_fields(self):
+        """Test a bad email and phone are both reported."""
+        with pytest.raises(ValidationError) as exc_info:
+            validate_mp({'name': 'John Doe', 'constituency': 'Test', 'email': 'jdoe@parliament', 'phone': '12345'})
+
+        assert exc_info.value.problems == [
+            "email 'jdoe@parliament' is not a valid email address",
+            "phone '12345' is not a valid phone number",
+        ]
+
+
+class TestNormalizePhone:
+    """Test suite for Kenyan phone normalization."""
+
+    @pytest.mark.parametrize("raw", [
+        "0712345678",
+        "0712 345 678",
+        "+254 712 345 678",
+        "+254-712-345678",
+        "254712345678",
+        "712345678",
+    ])
+    def test_mobile_forms(self, raw):
+        """Test common ways of writing a mobile number."""
+        assert normalize_phone(raw) == "+254712345678"
+
+    def test_landline(self):
+        """Test a Nairobi landline with an area code in brackets."""
+        assert normalize_phone("(020) 222 1291") == "+254202221291"
+
+    @pytest.mark.parametrize("raw,message", [
+        ("+256 712 345 678", "not a Kenyan number"),
+        ("0712 345 67", "not a valid phone number"),
+        ("0712-ABC-678", "not a valid phone number"),
+        ("", "not a valid phone number"),
+    ])
+    def test_invalid_numbers(self, raw, message):
+        """Test foreign, short and non-numeric values are rejected."""
+        with pytest.raises(ValidationError, match=message):
+            normalize_phone(raw)
+
+
+class TestValidateDataset:
+    """Test suite for dataset referential integrity."""
+
+    @pytest.fixture
+    def dataset(self):
+        """Create a consistent set of MPs, bills and votes."""
+        mps = [{'id': 1, 'name': 'John Doe'}, {'id': 2, 'name': 'Jane Smith'}]
+        bills = [
+            {'id': 'Finance Bill 2024', 'sponsor_mp_id': 1},
+            {'id': 'Health Bill 2024', 'sponsor_mp_id': None},
+        ]
+        votes = [
+            {'mp_id': 1, 'bill_id': 'Finance Bill 2024', 'position': 'aye'},
+            {'mp_id': 2, 'bill_id': 'Finance Bill 2024', 'position': 'no'},
+        ]
+        return mps, bills, votes
+
+    def test_consistent_dataset(self, dataset):
+        """Test a dataset with no dangling references passes."""
+        validate_dataset(*dataset)
+
+    def test_unknown_sponsor(self, dataset):
+        """Test a bill sponsored by an unknown MP is reported."""
+        mps, bills, votes = dataset
+        bills[0]['sponsor_mp_id'] = 99
+
+        with pytest.raises(ValidationError, match="sponsor_mp_id 99"):
+            validate_dataset(mps, bills, votes)
+
+    def test_unknown_co_sponsor(self, dataset):
+        """Test a co-sponsor who is not a known MP is reported."""
+        mps, bills, votes = dataset
+        bills[0]['co_sponsor_mp_ids'] = [2, 42]
+
+        with pytest.raises(ValidationError, match="co-sponsor 42"):
+            validate_dataset(mps, bills, votes)
+
+    def test_all_dangling_votes_reported(self, dataset):
+        """Test every dangling vote reference is listed."""
+        mps, bills, votes = dataset
+        votes.append({'mp_id': 7, 'bill_id': 'Unknown Bill', 'position': 'aye'})
+
+        with pytest.raises(ValidationError) as exc_info:
+            validate_dataset(mps, bills, votes)
+
+        assert exc_info.value.problems == [
+            "vote 2 mp_id 7 is not a known MP",
+            "vote 2 bill_id 'Unknown Bill' is not a known bill",
+        ]
+
+    def test_empty_dataset(self):
+        """Test empty inputs pass."""
+        validate_dataset([], [], [])
+
+
+class TestValidatePerformanceInputs:
+    """Test suite for performance score input validation."""
+
+    def test_valid_inputs(self):
+        """Test in-range components, including the bounds, pass."""
+        validate_performance_inputs(0.0, 100.0, 55.5)
+
+    def test_every_bad_input_listed(self):
+        """Test each out-of-range component is reported."""
+        with pytest.raises(ValidationError) as exc_info:
+            validate_performance_inputs(120.0, 50.0, -5.0)
+
+        assert exc_info.value.problems == [
+            "attendance 120.0 is outside 0-100",
+            "quality -5.0 is outside 0-100",
+        ]
+
+    def test_nan_rejected(self):
+        """Test a NaN component is not silently accepted."""
+        with pytest.raises(ValidationError, match="bills nan"):
+            validate_performance_inputs(50.0, float('nan'), 50.0)
+
+
+class TestValidateBreakdown:
+    """Test suite for performance breakdown consistency."""
+
+    def test_computed_breakdown_passes(self):
+        """Test a freshly computed breakdown is consistent."""
+        validate_breakdown(performance_breakdown(attendance=85.0, bills=40.0, quality=62.5))
+
+    def test_rounded_breakdown_passes(self):
+        """Test small rounding differences from storage are tolerated."""
+        validate_breakdown(PerformanceBreakdown(attendance=33.33, bills=13.33, quality=18.75, total=65.42))
+
+    def test_inconsistent_total(self):
+        """Test a total that does not match its components is rejected."""
+        with pytest.raises(ValidationError, match="components sum to 64.75 but total is 70.0"):
+            validate_breakdown(PerformanceBreakdown(attendance=34.0, bills=12.0, quality=18.75, total=70.0))
+
+    def test_every_problem_listed(self):
+        """Test an out-of-range and inconsistent total reports both problems."""
+        with pytest.raises(ValidationError) as exc_info:
+            validate_breakdown(PerformanceBreakdown(attendance=40.0, bills=30.0, quality=30.0, total=120.0))
+
+        assert exc_info.value.problems == [
+            "components sum to 100.0 but total is 120.0",
+            "total 120.0 is outside 0-100",
+        ]
+
+    def test_nan_rejected(self):
+        """Test a NaN total is never consistent."""
+        with pytest.raises(ValidationError):
+            validate_breakdown(PerformanceBreakdown(attendance=1.0, bills=1.0, quality=1.0, total=float('nan')))
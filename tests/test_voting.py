@@ -0,0 +1,115 @@
+"""
+Tests for voting analysis.
+"""
+
+import pytest
+
+from hansard_tales.analysis.voting import division_success_rate, normalize_position, party_cohesion
+
+
+@pytest.fixture
+def sample_mps():
+    """Create sample MP records across two parties."""
+    return [
+        {'id': 1, 'name': 'A', 'party': 'ODM'},
+        {'id': 2, 'name': 'B', 'party': 'ODM'},
+        {'id': 3, 'name': 'C', 'party': 'ODM'},
+        {'id': 4, 'name': 'D', 'party': 'ODM'},
+        {'id': 5, 'name': 'E', 'party': 'UDA'},
+        {'id': 6, 'name': 'F', 'party': 'UDA'},
+        {'id': 7, 'name': 'G', 'party': 'WDM'},
+        {'id': 8, 'name': 'H', 'party': None},
+    ]
+
+
+def vote(mp_id, position, bill_id='B1'):
+    """Build a vote record."""
+    return {'mp_id': mp_id, 'bill_id': bill_id, 'position': position}
+
+
+class TestNormalizePosition:
+    """Test suite for vote position normalization."""
+
+    def test_synonyms(self):
+        """Test common spellings map to the canonical positions."""
+        assert normalize_position('Yes') == 'aye'
+        assert normalize_position('NAY') == 'no'
+        assert normalize_position(' Abstained ') == 'abstain'
+
+    def test_unknown(self):
+        """Test unrecognised positions."""
+        assert normalize_position('absent') is None
+        assert normalize_position(None) is None
+
+
+class TestPartyCohesion:
+    """Test suite for party cohesion."""
+
+    def test_split_party(self, sample_mps):
+        """Test a party with one rebel."""
+        votes = [vote(1, 'aye'), vote(2, 'aye'), vote(3, 'aye'), vote(4, 'no')]
+        assert party_cohesion(votes, sample_mps, 'B1') == {'ODM': pytest.approx(0.75)}
+
+    def test_unanimous_party(self, sample_mps):
+        """Test a party voting together has full cohesion."""
+        votes = [vote(5, 'no'), vote(6, 'no')]
+        assert party_cohesion(votes, sample_mps, 'B1') == {'UDA': 1.0}
+
+    def test_single_voting_member(self, sample_mps):
+        """Test a party with one voter is fully cohesive."""
+        assert party_cohesion([vote(7, 'aye')], sample_mps, 'B1') == {'WDM': 1.0}
+
+    def test_even_split(self, sample_mps):
+        """Test an evenly split party has cohesion 0.5."""
+        votes = [vote(1, 'aye'), vote(2, 'no')]
+        assert party_cohesion(votes, sample_mps, 'B1')['ODM'] == pytest.approx(0.5)
+
+    def test_abstentions_excluded(self, sample_mps):
+        """Test abstentions do not count against cohesion."""
+        votes = [vote(1, 'aye'), vote(2, 'aye'), vote(3, 'abstain')]
+        assert party_cohesion(votes, sample_mps, 'B1')['ODM'] == 1.0
+
+    def test_only_abstentions_omitted(self, sample_mps):
+        """Test a party that only abstained is left out."""
+        assert party_cohesion([vote(5, 'abstain')], sample_mps, 'B1') == {}
+
+    def test_other_bills_ignored(self, sample_mps):
+        """Test votes on other bills are not counted."""
+        votes = [vote(1, 'aye'), vote(2, 'no', bill_id='B2')]
+        assert party_cohesion(votes, sample_mps, 'B1') == {'ODM': 1.0}
+
+    def test_unknown_and_partyless_mps_ignored(self, sample_mps):
+        """Test votes from unknown or partyless MPs are skipped."""
+        votes = [vote(8, 'aye'), vote(99, 'no')]
+        assert party_cohesion(votes, sample_mps, 'B1') == {}
+
+
+class TestDivisionSuccessRate:
+    """Test suite for an MP's share of votes on the winning side."""
+
+    def test_success_rate(self):
+        """Test matching votes are counted against the outcome of each bill."""
+        votes = [
+            {'mp_id': 1, 'bill_id': 'B1', 'position': 'Aye'},
+            {'mp_id': 1, 'bill_id': 'B2', 'position': 'No'},
+            {'mp_id': 1, 'bill_id': 'B3', 'position': 'aye'},
+            {'mp_id': 1, 'bill_id': 'B4', 'position': 'nay'},
+        ]
+        outcomes = {'B1': 'aye', 'B2': 'aye', 'B3': 'Ayes', 'B4': 'noes'}
+
+        assert division_success_rate(votes, outcomes) == 0.75
+
+    def test_abstentions_and_unknown_outcomes_excluded(self):
+        """Test abstentions and bills without an outcome are not counted."""
+        votes = [
+            {'mp_id': 1, 'bill_id': 'B1', 'position': 'Aye'},
+            {'mp_id': 1, 'bill_id': 'B2', 'position': 'Abstained'},
+            {'mp_id': 1, 'bill_id': 'B9', 'position': 'No'},
+        ]
+
+        assert division_success_rate(votes, {'B1': 'no', 'B2': 'aye'}) == 0.0
+
+    def test_no_counted_votes(self):
+        """Test an MP with no counted votes has no rate."""
+        assert division_success_rate([], {'B1': 'aye'}) is None
+        assert division_success_rate([{'bill_id': 'B1', 'position': 'abstain'}], {'B1': 'aye'}) is None